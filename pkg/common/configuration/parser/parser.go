@@ -5,11 +5,19 @@ import (
 	"encoding/json"
 	"encoding/xml"
 	"errors"
+	"fmt"
+	"github.com/dl1998/go-logging/pkg/common/formatter"
+	"github.com/dl1998/go-logging/pkg/common/handler"
+	"github.com/dl1998/go-logging/pkg/common/level"
+	"github.com/dl1998/go-logging/pkg/common/redaction"
 	"gopkg.in/yaml.v3"
 	"io"
 	"os"
+	"regexp"
 	"sort"
 	"strconv"
+	"strings"
+	"time"
 )
 
 var (
@@ -125,6 +133,90 @@ type FormatterConfiguration struct {
 	PairSeparator string `json:"pair-separator" yaml:"pair-separator" xml:"pair-separator"`
 	// Template is a template used by the formatter.
 	Template TemplateConfiguration `json:"template" yaml:"template" xml:"template"`
+	// Sanitize is a sanitization mode of the user-supplied values: "escape",
+	// "replace", "strip" or "none". Empty value leaves decision to the handler.
+	Sanitize string `json:"sanitize" yaml:"sanitize" xml:"sanitize"`
+	// Colors is a color scheme used by the formatter for the colored output.
+	Colors ColorSchemeConfiguration `json:"colors" yaml:"colors" xml:"colors"`
+}
+
+// SanitizeMode returns sanitization mode for the Sanitize of the formatter
+// configuration.
+func (configuration FormatterConfiguration) SanitizeMode() (formatter.SanitizeMode, error) {
+	return formatter.ParseSanitizeMode(configuration.Sanitize)
+}
+
+// ColorSchemeConfiguration is a struct that represents the configuration of the
+// color scheme. Colors are defined by name (e.g. "bold red", "white on red"),
+// number of the 256-color palette (e.g. "208") or hex code (e.g. "#ff8800").
+type ColorSchemeConfiguration struct {
+	// Mode defines which part of the log record is colored: "line", "level" or
+	// "message". Empty value leaves decision to the formatter.
+	Mode string `json:"mode" yaml:"mode" xml:"mode"`
+	// Key is a color of the parameter keys, "none" disables coloring of keys.
+	Key string `json:"key" yaml:"key" xml:"key"`
+	// Levels maps names of the levels to their colors, levels that are not
+	// listed use default colors.
+	Levels KeyValue `json:"levels" yaml:"levels" xml:"levels"`
+}
+
+// ColorScheme returns formatter.ColorScheme for the color scheme
+// configuration, it returns nil if color scheme is not configured. It returns
+// error if mode, level or any of the colors is invalid.
+func (configuration ColorSchemeConfiguration) ColorScheme() (*formatter.ColorScheme, error) {
+	if configuration.Mode == "" && configuration.Key == "" && len(configuration.Levels) == 0 {
+		return nil, nil
+	}
+
+	scheme := formatter.DefaultColorScheme()
+
+	mode, err := formatter.ParseColorMode(configuration.Mode)
+	if err != nil {
+		return nil, err
+	}
+	scheme.Mode = mode
+
+	if configuration.Key != "" {
+		if scheme.Key, err = formatter.ParseColor(configuration.Key); err != nil {
+			return nil, err
+		}
+	}
+
+	for name, definition := range configuration.Levels {
+		logLevel := level.ParseLevel(strings.ToLower(name))
+		if logLevel == level.Null && !strings.EqualFold(name, level.Null.String()) {
+			return nil, fmt.Errorf("unknown level %q", name)
+		}
+		if scheme.Levels[logLevel], err = formatter.ParseColor(definition); err != nil {
+			return nil, err
+		}
+	}
+
+	return scheme, nil
+}
+
+// LimitsConfiguration is a struct that represents the configuration of the
+// limits of the values and log records, zero value means that it is not
+// limited.
+type LimitsConfiguration struct {
+	// MaxValueLength is a maximum length of the string value in bytes.
+	MaxValueLength int `json:"max-value-length" yaml:"max-value-length" xml:"max-value-length"`
+	// MaxCollectionElements is a maximum number of elements of the collections.
+	MaxCollectionElements int `json:"max-collection-elements" yaml:"max-collection-elements" xml:"max-collection-elements"`
+	// MaxDepth is a maximum nesting depth of the collections.
+	MaxDepth int `json:"max-depth" yaml:"max-depth" xml:"max-depth"`
+	// MaxRecordSize is a maximum size of the formatted log record in bytes.
+	MaxRecordSize int `json:"max-record-size" yaml:"max-record-size" xml:"max-record-size"`
+}
+
+// Limits returns formatter.Limits for the limits configuration.
+func (configuration LimitsConfiguration) Limits() formatter.Limits {
+	return formatter.Limits{
+		MaxValueLength:        configuration.MaxValueLength,
+		MaxCollectionElements: configuration.MaxCollectionElements,
+		MaxDepth:              configuration.MaxDepth,
+		MaxRecordSize:         configuration.MaxRecordSize,
+	}
 }
 
 // HandlerConfiguration is a struct that represents the configuration of a handler.
@@ -140,6 +232,91 @@ type HandlerConfiguration struct {
 	File string `json:"file" yaml:"file" xml:"file"`
 	// Formatter is the formatter used by the handler to format log messages.
 	Formatter FormatterConfiguration `json:"formatter" yaml:"formatter" xml:"formatter"`
+	// Limits are limits of the values and log records written by the handler.
+	Limits LimitsConfiguration `json:"limits" yaml:"limits" xml:"limits"`
+	// Color defines whether the handler writes colored output: "auto",
+	// "always" or "never". Default: "auto".
+	Color string `json:"color" yaml:"color" xml:"color"`
+}
+
+// ColorOutput returns handler.ColorOutput for the Color of the handler
+// configuration.
+func (configuration HandlerConfiguration) ColorOutput() (handler.ColorOutput, error) {
+	return handler.ParseColorOutput(configuration.Color)
+}
+
+// RedactionRuleConfiguration is a struct that represents the configuration of
+// a redaction rule.
+type RedactionRuleConfiguration struct {
+	// Key is a pattern of the key name, it matches keys that contain it
+	// (case-insensitive).
+	Key string `json:"key" yaml:"key" xml:"key"`
+	// Value is a regular expression of the sensitive value.
+	Value string `json:"value" yaml:"value" xml:"value"`
+	// Pattern is a name of the predefined regular expression of the sensitive
+	// value: "credit-card", "email" or "jwt".
+	Pattern string `json:"pattern" yaml:"pattern" xml:"pattern"`
+	// Action is an action applied to the sensitive value: "replace", "drop",
+	// "hash" or "last4". Default: "replace".
+	Action string `json:"action" yaml:"action" xml:"action"`
+}
+
+// Rule returns redaction.Rule for the redaction rule configuration. It returns
+// error if regular expression, pattern or action is invalid.
+func (configuration RedactionRuleConfiguration) Rule() (redaction.Rule, error) {
+	action, err := redaction.ParseAction(configuration.Action)
+	if err != nil {
+		return redaction.Rule{}, err
+	}
+	rule := redaction.Rule{Key: configuration.Key, Action: action}
+	switch {
+	case configuration.Value != "":
+		rule.Value, err = regexp.Compile(configuration.Value)
+	case configuration.Pattern != "":
+		rule.Value, err = redaction.Pattern(configuration.Pattern)
+	}
+	if err != nil {
+		return redaction.Rule{}, err
+	}
+	if rule.Key == "" && rule.Value == nil {
+		return redaction.Rule{}, errors.New("redaction rule requires key, value or pattern")
+	}
+	return rule, nil
+}
+
+// RedactionConfiguration is a struct that represents the configuration of the
+// redaction of the sensitive data.
+type RedactionConfiguration struct {
+	// Salt is a salt used by the "hash" action, it could reference environment
+	// variables.
+	Salt string `json:"salt" yaml:"salt" xml:"salt"`
+	// Replacement is a replacement used by the "replace" and "last4" actions.
+	// Default: "***".
+	Replacement string `json:"replacement" yaml:"replacement" xml:"replacement"`
+	// Rules is the list of redaction rules.
+	Rules []RedactionRuleConfiguration `json:"rules" yaml:"rules" xml:"rules>rule"`
+}
+
+// Redactor returns redaction.Redactor for the redaction configuration, it
+// returns nil redactor, if there are no rules. It returns error if any of the
+// rules is invalid.
+func (configuration RedactionConfiguration) Redactor() (*redaction.Redactor, error) {
+	if len(configuration.Rules) == 0 {
+		return nil, nil
+	}
+	rules := make([]redaction.Rule, 0, len(configuration.Rules))
+	for _, ruleConfiguration := range configuration.Rules {
+		rule, err := ruleConfiguration.Rule()
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	redactor := redaction.New(os.ExpandEnv(configuration.Salt), rules...)
+	if configuration.Replacement != "" {
+		redactor.SetReplacement(configuration.Replacement)
+	}
+	return redactor, nil
 }
 
 // LoggerConfiguration is a struct that represents the configuration of a logger.
@@ -148,10 +325,16 @@ type LoggerConfiguration struct {
 	Name string `json:"name" yaml:"name" xml:"name"`
 	// TimeFormat is the time format used by the logger.
 	TimeFormat string `json:"time-format" yaml:"time-format" xml:"time-format"`
+	// TimeZone is the name of the location used for the time of the log
+	// records, e.g. "UTC" or "Europe/Berlin". Empty value means local time.
+	TimeZone string `json:"time-zone" yaml:"time-zone" xml:"time-zone"`
 	// ErrorLevel is the error level used by the logger for raise/capture error.
 	ErrorLevel string `json:"error-level" yaml:"error-level" xml:"error-level"`
 	// PanicLevel is the panic level used by the logger for panic.
 	PanicLevel string `json:"panic-level" yaml:"panic-level" xml:"panic-level"`
+	// StackLevel is the level starting from which stack of the goroutine is
+	// attached to the log records.
+	StackLevel string `json:"stack-level" yaml:"stack-level" xml:"stack-level"`
 	// RequestTemplate is the template used by the logger for a request struct.
 	RequestTemplate string `json:"request-template" yaml:"request-template" xml:"request-template"`
 	// ResponseTemplate is the template used by the logger for a response struct.
@@ -162,16 +345,93 @@ type LoggerConfiguration struct {
 	ResponseMapping KeyValue `json:"response-mapping" yaml:"response-mapping" xml:"response-mapping"`
 	// MessageQueueSize is the size of the message queue used by async logger.
 	MessageQueueSize int `json:"message-queue-size" yaml:"message-queue-size" xml:"message-queue-size"`
+	// Redaction is the configuration of the redaction of the sensitive data.
+	Redaction RedactionConfiguration `json:"redaction" yaml:"redaction" xml:"redaction"`
+	// Level is the minimum level of the log records, empty value means that
+	// level is inherited from the parent logger in the hierarchy.
+	Level string `json:"level" yaml:"level" xml:"level"`
+	// Propagate defines whether log records are passed to the handlers of the
+	// parent logger in the hierarchy, it is enabled if not set.
+	Propagate *bool `json:"propagate" yaml:"propagate" xml:"propagate"`
 	// Handlers is the list of handlers used by the logger.
 	Handlers []HandlerConfiguration `json:"handlers" yaml:"handlers" xml:"handlers>handler"`
 }
 
+// Location returns location for the TimeZone of the logger configuration, it
+// returns nil location for the empty TimeZone.
+func (configuration LoggerConfiguration) Location() (*time.Location, error) {
+	if configuration.TimeZone == "" {
+		return nil, nil
+	}
+	return time.LoadLocation(configuration.TimeZone)
+}
+
+// MinimumLevel returns minimum level of the log records, it returns level.All
+// for the empty Level, so level is inherited from the parent logger. It
+// returns error if Level is unknown, so typo never disables the logger.
+func (configuration LoggerConfiguration) MinimumLevel() (level.Level, error) {
+	if configuration.Level == "" {
+		return level.All, nil
+	}
+	return parseLevel(configuration.Level)
+}
+
+// StackCaptureLevel returns level starting from which stack of the goroutine
+// is captured, it returns level.Null for the empty StackLevel, so stack is not
+// captured. It returns error if StackLevel is unknown, so typo never disables
+// capturing of the stack.
+func (configuration LoggerConfiguration) StackCaptureLevel() (level.Level, error) {
+	if configuration.StackLevel == "" {
+		return level.Null, nil
+	}
+	return parseLevel(configuration.StackLevel)
+}
+
+// parseLevel returns level by its name in any case, it returns error if name
+// is unknown.
+func parseLevel(name string) (level.Level, error) {
+	logLevel := level.ParseLevel(strings.ToLower(name))
+	if logLevel == level.Null && !strings.EqualFold(name, level.Null.String()) {
+		return level.Null, fmt.Errorf("unknown level %q", name)
+	}
+	return logLevel, nil
+}
+
+// Propagates returns true, if log records are passed to the handlers of the
+// parent logger, it returns true if Propagate is not set.
+func (configuration LoggerConfiguration) Propagates() bool {
+	return configuration.Propagate == nil || *configuration.Propagate
+}
+
 // Configuration is a struct that represents the configuration.
 type Configuration struct {
+	// Placeholders is the map of the static placeholders that could be used in
+	// the templates, values could reference environment variables, e.g.
+	// "${APP_ENV}".
+	Placeholders KeyValue `json:"placeholders" yaml:"placeholders" xml:"placeholders"`
+	// PathPrefix is the prefix trimmed from the file paths in the
+	// "%(shortfile)" and "%(caller)" placeholders, it could reference
+	// environment variables.
+	PathPrefix string `json:"path-prefix" yaml:"path-prefix" xml:"path-prefix"`
 	// Loggers is the list of loggers present in the configuration.
 	Loggers []LoggerConfiguration `json:"loggers" yaml:"loggers" xml:"loggers>logger"`
 }
 
+// RegisterPlaceholders registers static placeholders from the configuration,
+// so they could be used in the templates of the formatters. It also sets path
+// prefix for the caller placeholders, if it is present in the configuration.
+func (configuration *Configuration) RegisterPlaceholders() error {
+	if configuration.PathPrefix != "" {
+		formatter.SetPathPrefix(os.ExpandEnv(configuration.PathPrefix))
+	}
+	for name, value := range configuration.Placeholders {
+		if err := formatter.RegisterStaticPlaceholder(name, os.ExpandEnv(value)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // readFromFile reads the configuration from the file and unmarshal it into the
 // configuration struct.
 func readFromFile(path string, unmarshal func([]byte, any) error) (*Configuration, error) {
@@ -6,7 +6,12 @@ import (
 	"encoding/xml"
 	"fmt"
 	"github.com/dl1998/go-logging/internal/testutils"
+	"github.com/dl1998/go-logging/pkg/common/formatter"
+	"github.com/dl1998/go-logging/pkg/common/handler"
+	"github.com/dl1998/go-logging/pkg/common/level"
+	"github.com/dl1998/go-logging/pkg/common/redaction"
 	"testing"
+	"time"
 )
 
 var (
@@ -329,3 +334,331 @@ func BenchmarkReadFromXML(b *testing.B) {
 		_, _ = ReadFromXML(testFile)
 	}
 }
+
+// TestConfiguration_RegisterPlaceholders tests that
+// Configuration.RegisterPlaceholders registers static placeholders with
+// expanded environment variables.
+func TestConfiguration_RegisterPlaceholders(t *testing.T) {
+	t.Setenv("TEST_ENVIRONMENT", "production")
+
+	configuration := &Configuration{
+		Placeholders: KeyValue{
+			"test-service": "example",
+			"test-env":     "${TEST_ENVIRONMENT}",
+		},
+	}
+
+	err := configuration.RegisterPlaceholders()
+
+	testutils.AssertNil(t, err)
+
+	service, _ := formatter.KeyValue("test-service", nil)
+	environment, _ := formatter.KeyValue("test-env", nil)
+
+	testutils.AssertEquals(t, interface{}("example"), service)
+	testutils.AssertEquals(t, interface{}("production"), environment)
+}
+
+// TestConfiguration_RegisterPlaceholders_Error tests that
+// Configuration.RegisterPlaceholders returns error for invalid placeholder name.
+func TestConfiguration_RegisterPlaceholders_Error(t *testing.T) {
+	configuration := &Configuration{
+		Placeholders: KeyValue{
+			"invalid:name": "value",
+		},
+	}
+
+	testutils.AssertNotNil(t, configuration.RegisterPlaceholders())
+}
+
+// TestConfiguration_RegisterPlaceholders_PathPrefix tests that
+// Configuration.RegisterPlaceholders sets path prefix for the caller
+// placeholders.
+func TestConfiguration_RegisterPlaceholders_PathPrefix(t *testing.T) {
+	defer formatter.SetPathPrefix("")
+
+	t.Setenv("TEST_MODULE_ROOT", "/build/module")
+
+	configuration := &Configuration{
+		PathPrefix: "${TEST_MODULE_ROOT}",
+	}
+
+	err := configuration.RegisterPlaceholders()
+
+	testutils.AssertNil(t, err)
+	testutils.AssertEquals(t, "/build/module", formatter.PathPrefix())
+}
+
+// BenchmarkConfiguration_RegisterPlaceholders benchmarks the
+// Configuration.RegisterPlaceholders function.
+func BenchmarkConfiguration_RegisterPlaceholders(b *testing.B) {
+	configuration := &Configuration{
+		Placeholders: KeyValue{
+			"test-service": "example",
+		},
+	}
+
+	for index := 0; index < b.N; index++ {
+		_ = configuration.RegisterPlaceholders()
+	}
+}
+
+// TestLoggerConfiguration_Location tests that LoggerConfiguration.Location
+// returns location for the time zone.
+func TestLoggerConfiguration_Location(t *testing.T) {
+	tests := map[string]struct {
+		timeZone string
+		expected *time.Location
+	}{
+		"Empty": {timeZone: "", expected: nil},
+		"UTC":   {timeZone: "UTC", expected: time.UTC},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			location, err := LoggerConfiguration{TimeZone: test.timeZone}.Location()
+
+			testutils.AssertNil(t, err)
+			testutils.AssertEquals(t, test.expected, location)
+		})
+	}
+}
+
+// TestLoggerConfiguration_Location_Error tests that LoggerConfiguration.Location
+// returns error for the unknown time zone.
+func TestLoggerConfiguration_Location_Error(t *testing.T) {
+	_, err := LoggerConfiguration{TimeZone: "Unknown/Zone"}.Location()
+
+	testutils.AssertNotNil(t, err)
+}
+
+// BenchmarkLoggerConfiguration_Location benchmarks the
+// LoggerConfiguration.Location function.
+func BenchmarkLoggerConfiguration_Location(b *testing.B) {
+	configuration := LoggerConfiguration{TimeZone: "UTC"}
+
+	for index := 0; index < b.N; index++ {
+		_, _ = configuration.Location()
+	}
+}
+
+// TestLoggerConfiguration_MinimumLevel tests that
+// LoggerConfiguration.MinimumLevel returns level.All for the empty level.
+func TestLoggerConfiguration_MinimumLevel(t *testing.T) {
+	tests := map[string]struct {
+		level    string
+		expected level.Level
+	}{
+		"Empty": {"", level.All},
+		"Level": {"INFO", level.Info},
+		"Null":  {"null", level.Null},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			logLevel, err := LoggerConfiguration{Level: test.level}.MinimumLevel()
+
+			testutils.AssertNil(t, err)
+			testutils.AssertEquals(t, test.expected, logLevel)
+		})
+	}
+}
+
+// TestLoggerConfiguration_MinimumLevel_Error tests that
+// LoggerConfiguration.MinimumLevel returns error for the unknown level.
+func TestLoggerConfiguration_MinimumLevel_Error(t *testing.T) {
+	_, err := LoggerConfiguration{Level: "informational"}.MinimumLevel()
+
+	testutils.AssertNotNil(t, err)
+}
+
+// TestLoggerConfiguration_StackCaptureLevel tests that
+// LoggerConfiguration.StackCaptureLevel returns level.Null for the empty level.
+func TestLoggerConfiguration_StackCaptureLevel(t *testing.T) {
+	tests := map[string]struct {
+		level    string
+		expected level.Level
+	}{
+		"Empty": {"", level.Null},
+		"Level": {"Error", level.Error},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			logLevel, err := LoggerConfiguration{StackLevel: test.level}.StackCaptureLevel()
+
+			testutils.AssertNil(t, err)
+			testutils.AssertEquals(t, test.expected, logLevel)
+		})
+	}
+}
+
+// TestLoggerConfiguration_StackCaptureLevel_Error tests that
+// LoggerConfiguration.StackCaptureLevel returns error for the unknown level.
+func TestLoggerConfiguration_StackCaptureLevel_Error(t *testing.T) {
+	_, err := LoggerConfiguration{StackLevel: "eror"}.StackCaptureLevel()
+
+	testutils.AssertNotNil(t, err)
+}
+
+// TestLoggerConfiguration_Propagates tests that LoggerConfiguration.Propagates
+// returns true if propagation is not set.
+func TestLoggerConfiguration_Propagates(t *testing.T) {
+	disabled := false
+
+	testutils.AssertEquals(t, true, LoggerConfiguration{}.Propagates())
+	testutils.AssertEquals(t, false, LoggerConfiguration{Propagate: &disabled}.Propagates())
+}
+
+// TestFormatterConfiguration_SanitizeMode tests that
+// FormatterConfiguration.SanitizeMode returns sanitization mode by its name.
+func TestFormatterConfiguration_SanitizeMode(t *testing.T) {
+	mode, err := FormatterConfiguration{Sanitize: "strip"}.SanitizeMode()
+
+	testutils.AssertNil(t, err)
+	testutils.AssertEquals(t, formatter.SanitizeStrip, mode)
+
+	_, err = FormatterConfiguration{Sanitize: "unknown"}.SanitizeMode()
+
+	testutils.AssertNotNil(t, err)
+}
+
+// TestColorSchemeConfiguration_ColorScheme tests that
+// ColorSchemeConfiguration.ColorScheme returns default color scheme with
+// configured mode, key color and level colors.
+func TestColorSchemeConfiguration_ColorScheme(t *testing.T) {
+	configuration := ColorSchemeConfiguration{
+		Mode:   "level",
+		Key:    "none",
+		Levels: KeyValue{"Error": "bold #ff0000", "info": "green"},
+	}
+
+	scheme, err := configuration.ColorScheme()
+
+	testutils.AssertNil(t, err)
+	testutils.AssertEquals(t, formatter.ColorLevel, scheme.Mode)
+	testutils.AssertEquals(t, formatter.Color(""), scheme.Key)
+	testutils.AssertEquals(t, formatter.Color("\033[1;38;2;255;0;0m"), scheme.Level(level.Error))
+	testutils.AssertEquals(t, formatter.Color("\033[32m"), scheme.Level(level.Info))
+	testutils.AssertEquals(t, formatter.DefaultColorScheme().Level(level.Debug), scheme.Level(level.Debug))
+
+	scheme, err = ColorSchemeConfiguration{}.ColorScheme()
+
+	testutils.AssertNil(t, err)
+	testutils.AssertNil(t, scheme)
+}
+
+// TestColorSchemeConfiguration_ColorScheme_Error tests that
+// ColorSchemeConfiguration.ColorScheme returns error for the invalid
+// configuration.
+func TestColorSchemeConfiguration_ColorScheme_Error(t *testing.T) {
+	tests := map[string]ColorSchemeConfiguration{
+		"Mode":        {Mode: "unknown"},
+		"Key":         {Key: "unknown"},
+		"Level":       {Levels: KeyValue{"unknown": "red"}},
+		"Level Color": {Levels: KeyValue{"error": "unknown"}},
+	}
+
+	for name, configuration := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := configuration.ColorScheme()
+
+			testutils.AssertNotNil(t, err)
+		})
+	}
+}
+
+// TestHandlerConfiguration_ColorOutput tests that
+// HandlerConfiguration.ColorOutput returns color output mode by its name.
+func TestHandlerConfiguration_ColorOutput(t *testing.T) {
+	output, err := HandlerConfiguration{Color: "never"}.ColorOutput()
+
+	testutils.AssertNil(t, err)
+	testutils.AssertEquals(t, handler.ColorNever, output)
+
+	_, err = HandlerConfiguration{Color: "unknown"}.ColorOutput()
+
+	testutils.AssertNotNil(t, err)
+}
+
+// TestLimitsConfiguration_Limits tests that LimitsConfiguration.Limits returns
+// formatter.Limits with configured values.
+func TestLimitsConfiguration_Limits(t *testing.T) {
+	configuration := LimitsConfiguration{
+		MaxValueLength:        1,
+		MaxCollectionElements: 2,
+		MaxDepth:              3,
+		MaxRecordSize:         4,
+	}
+
+	expected := formatter.Limits{
+		MaxValueLength:        1,
+		MaxCollectionElements: 2,
+		MaxDepth:              3,
+		MaxRecordSize:         4,
+	}
+
+	testutils.AssertEquals(t, expected, configuration.Limits())
+}
+
+// TestRedactionRuleConfiguration_Rule tests that RedactionRuleConfiguration.Rule
+// returns redaction.Rule and error for the invalid configuration.
+func TestRedactionRuleConfiguration_Rule(t *testing.T) {
+	rule, err := RedactionRuleConfiguration{Key: "password", Action: "drop"}.Rule()
+
+	testutils.AssertNil(t, err)
+	testutils.AssertEquals(t, redaction.Rule{Key: "password", Action: redaction.Drop}, rule)
+
+	rule, err = RedactionRuleConfiguration{Pattern: "email", Action: "hash"}.Rule()
+
+	testutils.AssertNil(t, err)
+	testutils.AssertEquals(t, redaction.EmailPattern, rule.Value)
+
+	rule, err = RedactionRuleConfiguration{Value: `\d{4}`}.Rule()
+
+	testutils.AssertNil(t, err)
+	testutils.AssertEquals(t, `\d{4}`, rule.Value.String())
+
+	invalid := map[string]RedactionRuleConfiguration{
+		"Empty":   {},
+		"Action":  {Key: "password", Action: "unknown"},
+		"Value":   {Value: "("},
+		"Pattern": {Pattern: "unknown"},
+	}
+
+	for name, configuration := range invalid {
+		t.Run(name, func(t *testing.T) {
+			_, err := configuration.Rule()
+
+			testutils.AssertNotNil(t, err)
+		})
+	}
+}
+
+// TestRedactionConfiguration_Redactor tests that RedactionConfiguration.Redactor
+// returns redactor with rules from the configuration and nil redactor, if there
+// are no rules.
+func TestRedactionConfiguration_Redactor(t *testing.T) {
+	redactor, err := RedactionConfiguration{}.Redactor()
+
+	testutils.AssertNil(t, err)
+	testutils.AssertNil(t, redactor)
+
+	t.Setenv("TEST_REDACTION_SALT", "salt")
+
+	configuration := RedactionConfiguration{
+		Salt:        "${TEST_REDACTION_SALT}",
+		Replacement: "[REDACTED]",
+		Rules:       []RedactionRuleConfiguration{{Key: "token"}},
+	}
+
+	redactor, err = configuration.Redactor()
+
+	testutils.AssertNil(t, err)
+	testutils.AssertEquals(t, "[REDACTED]", redactor.Replacement())
+	testutils.AssertEquals(t, "[REDACTED]", redactor.RedactMap(map[string]interface{}{"token": "secret"})["token"])
+
+	configuration.Rules = append(configuration.Rules, RedactionRuleConfiguration{})
+
+	_, err = configuration.Redactor()
+
+	testutils.AssertNotNil(t, err)
+}
@@ -0,0 +1,257 @@
+package formatter
+
+import (
+	"fmt"
+	"github.com/dl1998/go-logging/pkg/common/level"
+	"maps"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+// ColorReset is an ANSI escape sequence that resets color of the text.
+const ColorReset = "\033[0m"
+
+// Color is an ANSI escape sequence that sets color of the text, empty Color
+// leaves text uncolored.
+type Color string
+
+// Wrap wraps text into the color. Text is returned as is, if color is empty.
+func (color Color) Wrap(text string) string {
+	if color == "" {
+		return text
+	}
+	return string(color) + text + ColorReset
+}
+
+// colorCodes maps names of the colors to the ANSI foreground color codes,
+// background color codes are greater by 10.
+var colorCodes = map[string]int{
+	"black":          30,
+	"red":            31,
+	"green":          32,
+	"yellow":         33,
+	"blue":           34,
+	"magenta":        35,
+	"cyan":           36,
+	"white":          37,
+	"grey":           90,
+	"gray":           90,
+	"bright-red":     91,
+	"bright-green":   92,
+	"bright-yellow":  93,
+	"bright-blue":    94,
+	"bright-magenta": 95,
+	"bright-cyan":    96,
+	"bright-white":   97,
+}
+
+// attributeCodes maps names of the text attributes to the ANSI codes.
+var attributeCodes = map[string]string{
+	"bold":      "1",
+	"dim":       "2",
+	"italic":    "3",
+	"underline": "4",
+	"blink":     "5",
+	"reverse":   "7",
+}
+
+// ParseColor parses color definition and returns Color. Definition consists of
+// the space separated attributes (e.g. "bold", "dim", "underline") and colors,
+// color preceded by "on" is used as a background. Color could be defined by its
+// name (e.g. "red", "bright-cyan"), by number of the 256-color palette (e.g.
+// "208") or by hex code of the true color (e.g. "#ff8800"). Definition "none"
+// or empty definition returns empty Color, definition that starts with ESC
+// character is returned as is. It returns error if definition is invalid.
+func ParseColor(definition string) (Color, error) {
+	definition = strings.TrimSpace(definition)
+	if definition == "" || strings.EqualFold(definition, "none") {
+		return "", nil
+	}
+	if strings.HasPrefix(definition, "\033") {
+		return Color(definition), nil
+	}
+
+	var codes []string
+	background := false
+	for _, token := range strings.Fields(strings.ToLower(definition)) {
+		if token == "on" {
+			background = true
+			continue
+		}
+		if code, ok := attributeCodes[token]; ok && !background {
+			codes = append(codes, code)
+			continue
+		}
+		code, err := parseColorCode(token, background)
+		if err != nil {
+			return "", fmt.Errorf("invalid color %q: %w", definition, err)
+		}
+		codes = append(codes, code)
+		background = false
+	}
+	if background {
+		return "", fmt.Errorf("invalid color %q: background color is missing", definition)
+	}
+
+	return Color("\033[" + strings.Join(codes, ";") + "m"), nil
+}
+
+// parseColorCode parses color token (name, 256-color number or hex code) and
+// returns its ANSI code for the foreground or background.
+func parseColorCode(token string, background bool) (string, error) {
+	offset, extended := 0, "38"
+	if background {
+		offset, extended = 10, "48"
+	}
+	if code, ok := colorCodes[token]; ok {
+		return strconv.Itoa(code + offset), nil
+	}
+	if strings.HasPrefix(token, "#") {
+		hex := strings.TrimPrefix(token, "#")
+		value, err := strconv.ParseUint(hex, 16, 32)
+		if err != nil || len(hex) != 6 {
+			return "", fmt.Errorf("unknown hex code %q", token)
+		}
+		return fmt.Sprintf("%s;2;%d;%d;%d", extended, value>>16, value>>8&0xff, value&0xff), nil
+	}
+	if number, err := strconv.Atoi(token); err == nil && number >= 0 && number <= 255 {
+		return fmt.Sprintf("%s;5;%d", extended, number), nil
+	}
+	return "", fmt.Errorf("unknown color %q", token)
+}
+
+// ColorMode defines which part of the log record is colored.
+type ColorMode int
+
+const (
+	// ColorDefault leaves decision to the formatter: console formatter colors
+	// level, other formatters color the whole log record.
+	ColorDefault ColorMode = iota
+	// ColorLine colors the whole log record.
+	ColorLine
+	// ColorLevel colors only the level of the log record.
+	ColorLevel
+	// ColorMessage colors only the message of the log record.
+	ColorMessage
+)
+
+// colorModeNames maps ColorMode values to their names.
+var colorModeNames = map[ColorMode]string{
+	ColorDefault: "default",
+	ColorLine:    "line",
+	ColorLevel:   "level",
+	ColorMessage: "message",
+}
+
+// String returns name of the ColorMode.
+func (mode ColorMode) String() string {
+	if name, ok := colorModeNames[mode]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// ParseColorMode returns ColorMode by its name, empty name is parsed as
+// ColorDefault. It returns error if name is unknown.
+func ParseColorMode(name string) (ColorMode, error) {
+	if name == "" {
+		return ColorDefault, nil
+	}
+	for mode, modeName := range colorModeNames {
+		if strings.EqualFold(name, modeName) {
+			return mode, nil
+		}
+	}
+	return ColorDefault, fmt.Errorf("unknown color mode %q", name)
+}
+
+// ColorScheme defines colors used by the formatters for the colored output.
+type ColorScheme struct {
+	// Levels maps levels to their colors, levels without color are not
+	// colored.
+	Levels map[level.Level]Color
+	// Key is a color of the parameter keys, it is not used if the whole log
+	// record is colored.
+	Key Color
+	// Mode defines which part of the log record is colored.
+	Mode ColorMode
+}
+
+// defaultLevelColors maps levels to their default colors.
+var defaultLevelColors = map[level.Level]Color{
+	level.Trace:     "\033[90m",         // Dark Grey
+	level.Debug:     "\033[36m",         // Cyan
+	level.Verbose:   "\033[96m",         // Light Cyan
+	level.Info:      "\033[97m",         // Default terminal text color (ANSI Bright White)
+	level.Notice:    "\033[94m",         // Light Blue
+	level.Warning:   "\033[93m",         // Bright Yellow
+	level.Severe:    "\033[38;5;208m",   // Orange
+	level.Error:     "\033[31m",         // Red
+	level.Alert:     "\033[38;5;202m",   // Dark Orange
+	level.Critical:  "\033[1;31m",       // Red or magenta (ANSI Bright Magenta)
+	level.Emergency: "\033[97m\033[41m", // Bright White on Red Background
+}
+
+// defaultKeyColor is a default color of the parameter keys (dim).
+const defaultKeyColor Color = "\033[2m"
+
+// DefaultColorScheme creates a new instance of the ColorScheme with default
+// colors, it could be modified without effect on other formatters.
+func DefaultColorScheme() *ColorScheme {
+	return &ColorScheme{
+		Levels: maps.Clone(defaultLevelColors),
+		Key:    defaultKeyColor,
+		Mode:   ColorDefault,
+	}
+}
+
+// Level returns color of the level.
+func (scheme *ColorScheme) Level(logLevel level.Level) Color {
+	return scheme.Levels[logLevel]
+}
+
+// Colorize wraps text into the color of the level.
+func (scheme *ColorScheme) Colorize(logLevel level.Level, text string) string {
+	return scheme.Level(logLevel).Wrap(text)
+}
+
+// ModeOr returns mode of the ColorScheme, or defaultMode if mode is
+// ColorDefault.
+func (scheme *ColorScheme) ModeOr(defaultMode ColorMode) ColorMode {
+	if scheme.Mode == ColorDefault {
+		return defaultMode
+	}
+	return scheme.Mode
+}
+
+// Colorable is an optional interface of the formatters, it allows to configure
+// color scheme of the colored output.
+type Colorable interface {
+	ColorScheme() *ColorScheme
+	SetColorScheme(scheme *ColorScheme)
+}
+
+// Coloring contains color scheme of the formatter, it is embedded by formatters
+// to implement Colorable interface. It is safe for concurrent use.
+type Coloring struct {
+	// scheme is a color scheme of the formatter.
+	scheme atomic.Pointer[ColorScheme]
+}
+
+// ColorScheme returns color scheme of the formatter, formatter without color
+// scheme gets its own copy of the default colors, so changes of the returned
+// ColorScheme never affect other formatters.
+func (coloring *Coloring) ColorScheme() *ColorScheme {
+	if scheme := coloring.scheme.Load(); scheme != nil {
+		return scheme
+	}
+	coloring.scheme.CompareAndSwap(nil, DefaultColorScheme())
+	return coloring.scheme.Load()
+}
+
+// SetColorScheme sets color scheme of the formatter, nil restores default
+// colors.
+func (coloring *Coloring) SetColorScheme(scheme *ColorScheme) {
+	coloring.scheme.Store(scheme)
+}
@@ -0,0 +1,157 @@
+package formatter
+
+import (
+	"github.com/dl1998/go-logging/internal/testutils"
+	"github.com/dl1998/go-logging/pkg/common/level"
+	"testing"
+)
+
+// TestColor_Wrap tests that Color.Wrap wraps text into the color and keeps
+// text as is for the empty color.
+func TestColor_Wrap(t *testing.T) {
+	testutils.AssertEquals(t, "\033[31mtext"+ColorReset, Color("\033[31m").Wrap("text"))
+	testutils.AssertEquals(t, "text", Color("").Wrap("text"))
+}
+
+// TestParseColor tests that ParseColor parses names, 256-color numbers, hex
+// codes, attributes and background colors.
+func TestParseColor(t *testing.T) {
+	tests := map[string]Color{
+		"":                     "",
+		"none":                 "",
+		"red":                  "\033[31m",
+		"Bright-Cyan":          "\033[96m",
+		"bold red":             "\033[1;31m",
+		"white on red":         "\033[37;41m",
+		"208":                  "\033[38;5;208m",
+		"on 236":               "\033[48;5;236m",
+		"#ff8800":              "\033[38;2;255;136;0m",
+		"dim #000000 on white": "\033[2;38;2;0;0;0;47m",
+		"\033[35m":             "\033[35m",
+	}
+
+	for definition, expected := range tests {
+		t.Run(definition, func(t *testing.T) {
+			color, err := ParseColor(definition)
+
+			testutils.AssertNil(t, err)
+			testutils.AssertEquals(t, expected, color)
+		})
+	}
+}
+
+// BenchmarkParseColor benchmarks the ParseColor function.
+func BenchmarkParseColor(b *testing.B) {
+	for index := 0; index < b.N; index++ {
+		_, _ = ParseColor("bold #ff8800 on 236")
+	}
+}
+
+// TestParseColor_Error tests that ParseColor returns error for the invalid
+// color definitions.
+func TestParseColor_Error(t *testing.T) {
+	for _, definition := range []string{"purple", "#ff88", "#gggggg", "256", "white on"} {
+		t.Run(definition, func(t *testing.T) {
+			_, err := ParseColor(definition)
+
+			testutils.AssertNotNil(t, err)
+		})
+	}
+}
+
+// TestParseColorMode tests that ParseColorMode returns mode by its name and
+// error for the unknown name.
+func TestParseColorMode(t *testing.T) {
+	tests := map[string]ColorMode{
+		"":        ColorDefault,
+		"line":    ColorLine,
+		"Level":   ColorLevel,
+		"MESSAGE": ColorMessage,
+	}
+
+	for name, expected := range tests {
+		t.Run(name, func(t *testing.T) {
+			mode, err := ParseColorMode(name)
+
+			testutils.AssertNil(t, err)
+			testutils.AssertEquals(t, expected, mode)
+		})
+	}
+
+	_, err := ParseColorMode("unknown")
+
+	testutils.AssertNotNil(t, err)
+}
+
+// TestColorMode_String tests that ColorMode.String returns name of the mode.
+func TestColorMode_String(t *testing.T) {
+	testutils.AssertEquals(t, "level", ColorLevel.String())
+	testutils.AssertEquals(t, "unknown", ColorMode(-1).String())
+}
+
+// TestDefaultColorScheme tests that DefaultColorScheme returns independent
+// copies of the default color scheme.
+func TestDefaultColorScheme(t *testing.T) {
+	scheme := DefaultColorScheme()
+	scheme.Levels[level.Debug] = "\033[35m"
+
+	testutils.AssertEquals(t, Color("\033[36m"), DefaultColorScheme().Level(level.Debug))
+	testutils.AssertEquals(t, Color("\033[2m"), scheme.Key)
+	testutils.AssertEquals(t, ColorDefault, scheme.Mode)
+}
+
+// TestColorScheme_Colorize tests that ColorScheme.Colorize wraps text into the
+// color of the level and keeps text as is for the level without color.
+func TestColorScheme_Colorize(t *testing.T) {
+	scheme := &ColorScheme{Levels: map[level.Level]Color{level.Error: "\033[31m"}}
+
+	testutils.AssertEquals(t, "\033[31merror"+ColorReset, scheme.Colorize(level.Error, "error"))
+	testutils.AssertEquals(t, "debug", scheme.Colorize(level.Debug, "debug"))
+}
+
+// TestColorScheme_ModeOr tests that ColorScheme.ModeOr returns default mode
+// only for the ColorDefault.
+func TestColorScheme_ModeOr(t *testing.T) {
+	testutils.AssertEquals(t, ColorLine, (&ColorScheme{}).ModeOr(ColorLine))
+	testutils.AssertEquals(t, ColorMessage, (&ColorScheme{Mode: ColorMessage}).ModeOr(ColorLine))
+}
+
+// TestColoring tests that Coloring returns default color scheme, if color
+// scheme is not set.
+func TestColoring(t *testing.T) {
+	coloring := &Coloring{}
+
+	testutils.AssertEquals(t, DefaultColorScheme(), coloring.ColorScheme())
+	testutils.AssertEquals(t, coloring.ColorScheme(), coloring.ColorScheme())
+
+	scheme := &ColorScheme{Mode: ColorLevel}
+	coloring.SetColorScheme(scheme)
+
+	testutils.AssertEquals(t, scheme, coloring.ColorScheme())
+}
+
+// TestColoring_ColorScheme_Independent tests that changes of the default color
+// scheme of one formatter do not affect other formatters.
+func TestColoring_ColorScheme_Independent(t *testing.T) {
+	first := &Coloring{}
+	second := &Coloring{}
+
+	first.ColorScheme().Levels[level.Error] = "\033[35m"
+	first.ColorScheme().Mode = ColorMessage
+
+	testutils.AssertEquals(t, Color("\033[31m"), second.ColorScheme().Level(level.Error))
+	testutils.AssertEquals(t, ColorDefault, second.ColorScheme().Mode)
+
+	first.SetColorScheme(nil)
+
+	testutils.AssertEquals(t, Color("\033[31m"), first.ColorScheme().Level(level.Error))
+}
+
+// BenchmarkColoring_ColorScheme benchmarks the Coloring.ColorScheme function.
+func BenchmarkColoring_ColorScheme(b *testing.B) {
+	coloring := &Coloring{}
+
+	for index := 0; index < b.N; index++ {
+		coloring.ColorScheme()
+	}
+}
@@ -2,31 +2,971 @@
 package formatter
 
 import (
+	"encoding/json"
+	"fmt"
+	"github.com/dl1998/go-logging/pkg/common/level"
 	"github.com/dl1998/go-logging/pkg/common/logrecord"
+	"github.com/dl1998/go-logging/pkg/common/tracing"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"text/template"
+	"text/template/parse"
+	"time"
+	"unicode/utf8"
 )
 
-// ParseKey parses the key and returns the value.
+// placeholderPattern matches placeholders in the template string, including
+// optional format specifier, e.g. "%(level)" or "%(level:-8s)".
+var placeholderPattern = regexp.MustCompile(`%\(([^():]+)(?::([^()]*))?\)`)
+
+// FieldPlaceholder is a name of the placeholder replaced with value of the
+// contextual field bound to the logger, e.g. "%(field:request_id)". Name of the
+// field could be followed by the format specifier, e.g. "%(field:user:-10s)".
+const FieldPlaceholder = "field"
+
+// ParsePlaceholder splits placeholder into the key name and the format
+// specifier, e.g. "%(level:-8s)" is split into "level" and "-8s". It returns
+// false, if provided string is not a placeholder.
+func ParsePlaceholder(placeholder string) (string, string, bool) {
+	if !strings.HasPrefix(placeholder, "%(") || !strings.HasSuffix(placeholder, ")") {
+		return "", "", false
+	}
+	content := placeholder[2 : len(placeholder)-1]
+	if content == "" || strings.ContainsAny(content, "()") {
+		return "", "", false
+	}
+	name, specifier, _ := strings.Cut(content, ":")
+	if name == "" {
+		return "", "", false
+	}
+	return name, specifier, true
+}
+
+// ValidateTemplate checks that all placeholders in the template have valid
+// format specifiers.
+func ValidateTemplate(template string) error {
+	_, err := CompileTemplate(template)
+	return err
+}
+
+// Segment represents a part of the compiled template, it is either literal text
+// or placeholder.
+type Segment struct {
+	// Text is a literal text or full placeholder text, e.g. "%(level:-8s)".
+	Text string
+	// Key is a name of the placeholder key, it is empty for the literal segment.
+	Key string
+	// Field is a name of the bound field of the FieldPlaceholder, it is empty
+	// for other segments.
+	Field string
+	// Specifier is a parsed format specifier of the placeholder, it is nil if
+	// placeholder does not have format specifier.
+	Specifier *Specifier
+}
+
+// IsPlaceholder returns true, if Segment is a placeholder.
+func (segment Segment) IsPlaceholder() bool {
+	return segment.Key != ""
+}
+
+// Template represents a template string compiled into the sequence of literal
+// and placeholder segments.
+type Template struct {
+	// segments is a list of the template segments.
+	segments []Segment
+}
+
+// CompileTemplate parses template string once into the sequence of literal and
+// placeholder segments. If template contains invalid format specifier, then
+// error is returned together with the compiled template where invalid
+// placeholders are treated as literals.
+func CompileTemplate(template string) (*Template, error) {
+	var compiled = &Template{segments: make([]Segment, 0)}
+	var compileError error
+	var position = 0
+
+	addLiteral := func(text string) {
+		if text == "" {
+			return
+		}
+		last := len(compiled.segments) - 1
+		if last >= 0 && !compiled.segments[last].IsPlaceholder() {
+			compiled.segments[last].Text += text
+			return
+		}
+		compiled.segments = append(compiled.segments, Segment{Text: text})
+	}
+
+	for _, match := range placeholderPattern.FindAllStringSubmatchIndex(template, -1) {
+		addLiteral(template[position:match[0]])
+		position = match[1]
+
+		placeholder := template[match[0]:match[1]]
+		segment := Segment{Text: placeholder, Key: template[match[2]:match[3]]}
+
+		invalid := func(err error) {
+			if compileError == nil {
+				compileError = fmt.Errorf("invalid placeholder %s: %w", placeholder, err)
+			}
+			addLiteral(placeholder)
+		}
+
+		specifier, hasSpecifier := "", match[4] >= 0
+		if hasSpecifier {
+			specifier = template[match[4]:match[5]]
+		}
+		if segment.Key == FieldPlaceholder {
+			segment.Field, specifier, hasSpecifier = strings.Cut(specifier, ":")
+			if segment.Field == "" {
+				invalid(fmt.Errorf("missing field name"))
+				continue
+			}
+		}
+
+		if hasSpecifier {
+			parsedSpecifier, err := ParseSpecifier(specifier)
+			if err != nil {
+				invalid(err)
+				continue
+			}
+			segment.Specifier = parsedSpecifier
+		}
+
+		compiled.segments = append(compiled.segments, segment)
+	}
+
+	addLiteral(template[position:])
+
+	return compiled, compileError
+}
+
+// Segments returns list of the compiled template segments.
+func (template *Template) Segments() []Segment {
+	return template.segments
+}
+
+// PlaceholderFunction is a function that returns value of the placeholder for
+// the log record.
+type PlaceholderFunction func(record logrecord.Interface) any
+
+// placeholders contains registered placeholders, it is replaced as a whole on
+// every registration, so it could be read without locking.
+var placeholders atomic.Pointer[map[string]PlaceholderFunction]
+
+// callerFreePlaceholders contains names of the registered placeholders, which
+// values do not depend on the caller information of the log record.
+var callerFreePlaceholders atomic.Pointer[map[string]bool]
+
+// placeholdersMutex synchronizes registration of the placeholders.
+var placeholdersMutex sync.Mutex
+
+func init() {
+	builtIn := map[string]PlaceholderFunction{
+		"name": func(record logrecord.Interface) any {
+			return record.Name()
+		},
+		"level": func(record logrecord.Interface) any {
+			return record.Level().String()
+		},
+		"levelnr": func(record logrecord.Interface) any {
+			return record.Level().DigitRepresentation()
+		},
+		"datetime": func(record logrecord.Interface) any {
+			return record.Time()
+		},
+		"timestamp": func(record logrecord.Interface) any {
+			return record.Timestamp()
+		},
+		"timestamp_ms": func(record logrecord.Interface) any {
+			return record.RawTime().UnixMilli()
+		},
+		"timestamp_us": func(record logrecord.Interface) any {
+			return record.RawTime().UnixMicro()
+		},
+		"timestamp_ns": func(record logrecord.Interface) any {
+			return record.RawTime().UnixNano()
+		},
+		"rfc3339nano": func(record logrecord.Interface) any {
+			return record.RawTime().Format(time.RFC3339Nano)
+		},
+		"fname": func(record logrecord.Interface) any {
+			return record.FileName()
+		},
+		"fline": func(record logrecord.Interface) any {
+			return record.FileLine()
+		},
+		"func": func(record logrecord.Interface) any {
+			_, function := SplitFunctionName(record.FunctionName())
+			return function
+		},
+		"package": func(record logrecord.Interface) any {
+			packageName, _ := SplitFunctionName(record.FunctionName())
+			return packageName
+		},
+		"shortfile": func(record logrecord.Interface) any {
+			return ShortFile(record.FileName())
+		},
+		"caller": func(record logrecord.Interface) any {
+			return Caller(record.FileName(), record.FileLine())
+		},
+		"stack": func(record logrecord.Interface) any {
+			return record.Stack().String()
+		},
+		"hostname": func(logrecord.Interface) any {
+			return hostname()
+		},
+		"pid": func(logrecord.Interface) any {
+			return pid
+		},
+		"process": func(logrecord.Interface) any {
+			return processName()
+		},
+		"goroutine": func(record logrecord.Interface) any {
+			return record.GoroutineID()
+		},
+		"uptime": func(record logrecord.Interface) any {
+			return record.Uptime()
+		},
+		"relative": func(record logrecord.Interface) any {
+			return milliseconds(record.Relative())
+		},
+		"delta": func(record logrecord.Interface) any {
+			return milliseconds(record.Delta())
+		},
+		"trace_id": func(record logrecord.Interface) any {
+			return FieldValue(tracing.TraceIDField, record)
+		},
+		"span_id": func(record logrecord.Interface) any {
+			return FieldValue(tracing.SpanIDField, record)
+		},
+		"trace_flags": func(record logrecord.Interface) any {
+			return FieldValue(tracing.TraceFlagsField, record)
+		},
+	}
+	placeholders.Store(&builtIn)
+
+	callerFree := map[string]bool{
+		"name":         true,
+		"level":        true,
+		"levelnr":      true,
+		"datetime":     true,
+		"timestamp":    true,
+		"timestamp_ms": true,
+		"timestamp_us": true,
+		"timestamp_ns": true,
+		"rfc3339nano":  true,
+		"stack":        true,
+		"hostname":     true,
+		"pid":          true,
+		"process":      true,
+		"goroutine":    true,
+		"uptime":       true,
+		"relative":     true,
+		"delta":        true,
+		"field":        true,
+		"trace_id":     true,
+		"span_id":      true,
+		"trace_flags":  true,
+	}
+	callerFreePlaceholders.Store(&callerFree)
+}
+
+// milliseconds converts duration to the fractional number of milliseconds.
+func milliseconds(duration time.Duration) float64 {
+	return float64(duration) / float64(time.Millisecond)
+}
+
+// RegisterPlaceholder registers a new placeholder with the provided name, it
+// could be used in the templates as "%(name)". Registration of the placeholder
+// with existing name replaces the previous one. Loggers always capture caller
+// information for the templates that use custom placeholders, because they
+// could depend on it.
+func RegisterPlaceholder(name string, function PlaceholderFunction) error {
+	return registerPlaceholder(name, function, true)
+}
+
+// registerPlaceholder registers a new placeholder with the provided name and
+// remembers whether it uses caller information of the log record.
+func registerPlaceholder(name string, function PlaceholderFunction, usesCaller bool) error {
+	if name == "" || strings.ContainsAny(name, "():") {
+		return fmt.Errorf("invalid placeholder name %q", name)
+	}
+	if function == nil {
+		return fmt.Errorf("placeholder %q has no function", name)
+	}
+
+	placeholdersMutex.Lock()
+	defer placeholdersMutex.Unlock()
+
+	current := *placeholders.Load()
+	updated := make(map[string]PlaceholderFunction, len(current)+1)
+	for key, value := range current {
+		updated[key] = value
+	}
+	updated[name] = function
+	placeholders.Store(&updated)
+
+	updateCallerFreePlaceholders(name, !usesCaller)
+
+	return nil
+}
+
+// RegisterStaticPlaceholder registers a new placeholder that always returns
+// the same value.
+func RegisterStaticPlaceholder(name string, value any) error {
+	return registerPlaceholder(name, func(logrecord.Interface) any {
+		return value
+	}, false)
+}
+
+// UnregisterPlaceholder removes placeholder with the provided name.
+func UnregisterPlaceholder(name string) {
+	placeholdersMutex.Lock()
+	defer placeholdersMutex.Unlock()
+
+	current := *placeholders.Load()
+	updated := make(map[string]PlaceholderFunction, len(current))
+	for key, value := range current {
+		if key != name {
+			updated[key] = value
+		}
+	}
+	placeholders.Store(&updated)
+
+	updateCallerFreePlaceholders(name, false)
+}
+
+// updateCallerFreePlaceholders adds placeholder name to the set of the
+// placeholders that do not use caller information or removes it from the set.
+// It shall be called with the locked placeholdersMutex.
+func updateCallerFreePlaceholders(name string, callerFree bool) {
+	current := *callerFreePlaceholders.Load()
+	updated := make(map[string]bool, len(current)+1)
+	for key, value := range current {
+		if key != name {
+			updated[key] = value
+		}
+	}
+	if callerFree {
+		updated[name] = true
+	}
+	callerFreePlaceholders.Store(&updated)
+}
+
+// PlaceholderUsesCaller returns true, if value of the placeholder could depend
+// on the caller information of the log record (file, line or function). Unknown
+// and custom placeholders are considered to use it.
+func PlaceholderUsesCaller(name string) bool {
+	return !(*callerFreePlaceholders.Load())[name]
+}
+
+// GoroutinePlaceholder is a name of the placeholder with identifier of the
+// goroutine, loggers capture identifier only if formatters use it.
+const GoroutinePlaceholder = "goroutine"
+
+// GoroutineUser is an optional interface of the formatters, it reports whether
+// formatter uses identifier of the goroutine. Loggers capture identifier of the
+// goroutine only if at least one of the formatters of their handlers uses it.
+type GoroutineUser interface {
+	UsesGoroutine() bool
+}
+
+// UsesGoroutine returns true, if formatter uses identifier of the goroutine.
+// Formatters that do not implement GoroutineUser are considered not to use it.
+func UsesGoroutine(formatter any) bool {
+	user, ok := formatter.(GoroutineUser)
+	return ok && user.UsesGoroutine()
+}
+
+// Uses returns true, if template contains placeholder with the provided name.
+func (template *Template) Uses(name string) bool {
+	for _, segment := range template.segments {
+		if segment.Key == name {
+			return true
+		}
+	}
+	return false
+}
+
+// CallerUser is an optional interface of the formatters, it reports whether
+// formatter uses caller information of the log record. Loggers skip lookup of
+// the caller, if none of the formatters of their handlers uses it.
+type CallerUser interface {
+	UsesCaller() bool
+}
+
+// UsesCaller returns true, if formatter uses caller information of the log
+// record. Formatters that do not implement CallerUser are considered to use it.
+func UsesCaller(formatter any) bool {
+	user, ok := formatter.(CallerUser)
+	return !ok || user.UsesCaller()
+}
+
+// UsesCaller returns true, if any placeholder of the template could depend on
+// the caller information of the log record. Placeholders listed in the ignored
+// names are skipped.
+func (template *Template) UsesCaller(ignored ...string) bool {
+	for _, segment := range template.segments {
+		if !segment.IsPlaceholder() || slices.Contains(ignored, segment.Key) {
+			continue
+		}
+		if PlaceholderUsesCaller(segment.Key) {
+			return true
+		}
+	}
+	return false
+}
+
+// pathPrefix contains prefix trimmed from the file paths of the callers.
+var pathPrefix atomic.Pointer[string]
+
+// SetPathPrefix sets prefix (e.g. module root directory) that is trimmed from
+// the file paths in the "%(shortfile)" and "%(caller)" placeholders. Empty
+// prefix restores default behavior.
+func SetPathPrefix(prefix string) {
+	pathPrefix.Store(&prefix)
+}
+
+// PathPrefix returns prefix trimmed from the file paths of the callers.
+func PathPrefix() string {
+	if prefix := pathPrefix.Load(); prefix != nil {
+		return *prefix
+	}
+	return ""
+}
+
+// trimPathPrefix trims configured path prefix from the file path, it returns
+// false if path does not start with the prefix.
+func trimPathPrefix(file string) (string, bool) {
+	prefix := PathPrefix()
+	if prefix == "" || !strings.HasPrefix(file, prefix) {
+		return file, false
+	}
+	return strings.TrimPrefix(strings.TrimPrefix(file, prefix), "/"), true
+}
+
+// ShortFile returns file path relative to the configured path prefix, or base
+// name of the file if path does not start with the prefix.
+func ShortFile(file string) string {
+	if trimmed, ok := trimPathPrefix(file); ok {
+		return trimmed
+	}
+	return path.Base(file)
+}
+
+// Caller returns short representation of the place in the code, e.g.
+// "package/file.go:123". File path is relative to the configured path prefix, or
+// contains only the last directory if path does not start with the prefix.
+func Caller(file string, line int) string {
+	trimmed, ok := trimPathPrefix(file)
+	if !ok {
+		trimmed = path.Join(path.Base(path.Dir(file)), path.Base(file))
+	}
+	return trimmed + ":" + strconv.Itoa(line)
+}
+
+// SplitFunctionName splits fully qualified function name into the package path
+// and function name, e.g. "github.com/user/module/package.(*Type).Method" is
+// split into "github.com/user/module/package" and "(*Type).Method". Dots
+// escaped by the runtime in the last element of the package path ("%2e") are
+// restored.
+func SplitFunctionName(name string) (string, string) {
+	lastSlash := strings.LastIndex(name, "/")
+	dot := strings.Index(name[lastSlash+1:], ".")
+	if dot < 0 {
+		return "", name
+	}
+	dot += lastSlash + 1
+	return strings.ReplaceAll(name[:dot], "%2e", "."), name[dot+1:]
+}
+
+// KeyValue returns value for the key name (without "%(" and ")") from the log
+// record, it returns false if the key is unknown.
+func KeyValue(name string, record logrecord.Interface) (interface{}, bool) {
+	function, ok := (*placeholders.Load())[name]
+	if !ok {
+		return nil, false
+	}
+	return function(record), true
+}
+
+// FieldValue returns value of the contextual field bound to the logger, it
+// returns empty string if log record does not have such field.
+func FieldValue(name string, record logrecord.Interface) interface{} {
+	if value, ok := record.Fields()[name]; ok {
+		return value
+	}
+	return ""
+}
+
+// SegmentValue returns value of the placeholder segment from the log record, it
+// returns false if the key is unknown.
+func SegmentValue(segment Segment, record logrecord.Interface) (interface{}, bool) {
+	if segment.Key == FieldPlaceholder {
+		return FieldValue(segment.Field, record), true
+	}
+	return KeyValue(segment.Key, record)
+}
+
+// ParseKey parses the key and returns the value. If key contains format
+// specifier, then formatted string is returned.
 func ParseKey(key string, record logrecord.Interface) interface{} {
-	var value interface{}
-
-	switch key {
-	case "%(name)":
-		value = record.Name()
-	case "%(level)":
-		value = record.Level().String()
-	case "%(levelnr)":
-		value = record.Level().DigitRepresentation()
-	case "%(datetime)":
-		value = record.Time()
-	case "%(timestamp)":
-		value = record.Timestamp()
-	case "%(fname)":
-		value = record.FileName()
-	case "%(fline)":
-		value = record.FileLine()
+	name, specifier, ok := ParsePlaceholder(key)
+	if !ok {
+		return key
+	}
+
+	if name == FieldPlaceholder {
+		field, fieldSpecifier, _ := strings.Cut(specifier, ":")
+		if field == "" {
+			return key
+		}
+		return ApplySpecifier(key, fieldSpecifier, FieldValue(field, record))
+	}
+
+	value, ok := KeyValue(name, record)
+	if !ok {
+		return key
+	}
+
+	return ApplySpecifier(key, specifier, value)
+}
+
+// ApplySpecifier formats value using specifier, if specifier is empty, then
+// value is returned unchanged. If specifier is invalid, then placeholder is
+// returned.
+func ApplySpecifier(placeholder string, specifier string, value interface{}) interface{} {
+	if specifier == "" {
+		return value
+	}
+
+	parsedSpecifier, err := ParseSpecifier(specifier)
+	if err != nil {
+		return placeholder
+	}
+
+	return parsedSpecifier.Format(value)
+}
+
+// Specifier represents format specifier of the placeholder, the grammar is
+// similar to the Python's format specification:
+//
+//	[[fill]align][-][0][width][.precision][type]
+//
+// Where align is one of '<', '>', '^', '-' is an alias for the left alignment,
+// '0' enables zero padding and type is one of 's', 'd', 'f', 'x', 'X'.
+type Specifier struct {
+	// fill is a character used for the padding.
+	fill rune
+	// align is an alignment of the value, zero if not set.
+	align rune
+	// zero indicates that value shall be padded with zeros.
+	zero bool
+	// width is a minimal width of the value.
+	width int
+	// precision is a number of digits after decimal point for floats or
+	// maximum length for strings, -1 if not set.
+	precision int
+	// verb is a type of the value, zero if not set.
+	verb rune
+}
+
+// MaxSpecifierWidth is a maximum width and precision of the format specifier,
+// it limits size of the padded values.
+const MaxSpecifierWidth = 1024
+
+// ParseSpecifier parses format specifier and returns Specifier. It returns
+// error, if width or precision is greater than MaxSpecifierWidth.
+func ParseSpecifier(specifier string) (*Specifier, error) {
+	if specifier == "" {
+		return nil, fmt.Errorf("empty format specifier")
+	}
+
+	var parsed = &Specifier{fill: ' ', precision: -1}
+	var characters = []rune(specifier)
+	var index = 0
+
+	isAlign := func(character rune) bool {
+		return character == '<' || character == '>' || character == '^'
+	}
+
+	if len(characters) >= 2 && isAlign(characters[1]) {
+		parsed.fill = characters[0]
+		parsed.align = characters[1]
+		index = 2
+	} else if isAlign(characters[0]) {
+		parsed.align = characters[0]
+		index = 1
+	}
+
+	if index < len(characters) && characters[index] == '-' {
+		if parsed.align != 0 {
+			return nil, fmt.Errorf("format specifier %q has more than one alignment", specifier)
+		}
+		parsed.align = '<'
+		index++
+	}
+
+	if index < len(characters) && characters[index] == '0' {
+		parsed.zero = true
+		index++
+	}
+
+	start := index
+	for index < len(characters) && characters[index] >= '0' && characters[index] <= '9' {
+		index++
+	}
+	if index > start {
+		width, err := strconv.Atoi(string(characters[start:index]))
+		if err != nil || width > MaxSpecifierWidth {
+			return nil, fmt.Errorf("format specifier %q has width greater than %d", specifier, MaxSpecifierWidth)
+		}
+		parsed.width = width
+	}
+
+	if index < len(characters) && characters[index] == '.' {
+		index++
+		start = index
+		for index < len(characters) && characters[index] >= '0' && characters[index] <= '9' {
+			index++
+		}
+		if index == start {
+			return nil, fmt.Errorf("format specifier %q has empty precision", specifier)
+		}
+		precision, err := strconv.Atoi(string(characters[start:index]))
+		if err != nil || precision > MaxSpecifierWidth {
+			return nil, fmt.Errorf("format specifier %q has precision greater than %d", specifier, MaxSpecifierWidth)
+		}
+		parsed.precision = precision
+	}
+
+	if index < len(characters) {
+		switch characters[index] {
+		case 's', 'd', 'f', 'x', 'X':
+			parsed.verb = characters[index]
+			index++
+		}
+	}
+
+	if index != len(characters) {
+		return nil, fmt.Errorf("format specifier %q contains unexpected %q", specifier, string(characters[index:]))
+	}
+
+	return parsed, nil
+}
+
+// isNumber returns true, if value is an integer or float number.
+func isNumber(value interface{}) bool {
+	switch value.(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// toInt64 converts integer value to int64, it returns false if value is not an
+// integer.
+func toInt64(value interface{}) (int64, bool) {
+	switch convertedValue := value.(type) {
+	case int:
+		return int64(convertedValue), true
+	case int8:
+		return int64(convertedValue), true
+	case int16:
+		return int64(convertedValue), true
+	case int32:
+		return int64(convertedValue), true
+	case int64:
+		return convertedValue, true
+	case uint:
+		return int64(convertedValue), true
+	case uint8:
+		return int64(convertedValue), true
+	case uint16:
+		return int64(convertedValue), true
+	case uint32:
+		return int64(convertedValue), true
+	case uint64:
+		return int64(convertedValue), true
+	default:
+		return 0, false
+	}
+}
+
+// toFloat64 converts number value to float64, it returns false if value is not
+// a number.
+func toFloat64(value interface{}) (float64, bool) {
+	switch convertedValue := value.(type) {
+	case float32:
+		return float64(convertedValue), true
+	case float64:
+		return convertedValue, true
+	default:
+		integer, ok := toInt64(value)
+		return float64(integer), ok
+	}
+}
+
+// Format formats value according to the Specifier and returns string.
+func (specifier *Specifier) Format(value interface{}) string {
+	var text string
+	var numeric = isNumber(value)
+
+	switch specifier.verb {
+	case 'd':
+		if integer, ok := toInt64(value); ok {
+			text = strconv.FormatInt(integer, 10)
+		} else {
+			text = fmt.Sprint(value)
+		}
+	case 'x', 'X':
+		if integer, ok := toInt64(value); ok {
+			text = strconv.FormatInt(integer, 16)
+			if specifier.verb == 'X' {
+				text = strings.ToUpper(text)
+			}
+		} else {
+			text = fmt.Sprint(value)
+		}
+	case 'f':
+		precision := specifier.precision
+		if precision < 0 {
+			precision = 6
+		}
+		if number, ok := toFloat64(value); ok {
+			text = strconv.FormatFloat(number, 'f', precision, 64)
+		} else {
+			text = fmt.Sprint(value)
+		}
 	default:
-		value = key
+		text = fmt.Sprint(value)
+		if specifier.precision >= 0 && utf8.RuneCountInString(text) > specifier.precision {
+			text = string([]rune(text)[:specifier.precision])
+		}
+	}
+
+	return specifier.pad(text, numeric && specifier.verb != 's')
+}
+
+// pad pads text to the width of the Specifier using fill character and
+// alignment.
+func (specifier *Specifier) pad(text string, numeric bool) string {
+	padding := specifier.width - utf8.RuneCountInString(text)
+	if padding <= 0 {
+		return text
+	}
+
+	align := specifier.align
+	fill := string(specifier.fill)
+
+	if specifier.zero && align == 0 {
+		if strings.HasPrefix(text, "-") {
+			return "-" + strings.Repeat("0", padding) + text[1:]
+		}
+		return strings.Repeat("0", padding) + text
+	}
+
+	if align == 0 {
+		if numeric {
+			align = '>'
+		} else {
+			align = '<'
+		}
 	}
 
-	return value
+	switch align {
+	case '>':
+		return strings.Repeat(fill, padding) + text
+	case '^':
+		left := padding / 2
+		return strings.Repeat(fill, left) + text + strings.Repeat(fill, padding-left)
+	default:
+		return text + strings.Repeat(fill, padding)
+	}
+}
+
+// TemplateCaller contains information about place in the code from which logger
+// has been called.
+type TemplateCaller struct {
+	// File is a name of the file from which logger has been called.
+	File string
+	// Line is a line in the file from which logger has been called.
+	Line int
+	// Function is a fully qualified name of the function from which logger has
+	// been called.
+	Function string
+}
+
+// TemplateRecord represents log record exposed to the text/template based
+// formatters.
+type TemplateRecord struct {
+	// Name is a name of the logger.
+	Name string
+	// Level is a level of the log record.
+	Level level.Level
+	// Time is a time of the log record.
+	Time time.Time
+	// Caller is a place in the code from which logger has been called.
+	Caller TemplateCaller
+	// Message is a message of the log record.
+	Message string
+	// Stack is a stack of the goroutine, it is empty if stack has not been
+	// captured.
+	Stack logrecord.Stack
+	// Goroutine is an identifier of the goroutine, it is 0 if identifier has
+	// not been captured.
+	Goroutine uint64
+	// Relative is a time elapsed since creation of the logger.
+	Relative time.Duration
+	// Delta is a time elapsed since the previous log record of the logger.
+	Delta time.Duration
+	// Parameters are parameters of the log record.
+	Parameters map[string]interface{}
+	// Fields are contextual fields bound to the logger.
+	Fields map[string]interface{}
+}
+
+// NewTemplateRecord creates a new TemplateRecord from the log record, message
+// and parameters shall be filled by the caller.
+func NewTemplateRecord(record logrecord.Interface) *TemplateRecord {
+	return &TemplateRecord{
+		Name:  record.Name(),
+		Level: record.Level(),
+		Time:  record.RawTime(),
+		Caller: TemplateCaller{
+			File:     record.FileName(),
+			Line:     record.FileLine(),
+			Function: record.FunctionName(),
+		},
+		Stack:     record.Stack(),
+		Goroutine: record.GoroutineID(),
+		Relative:  record.Relative(),
+		Delta:     record.Delta(),
+		Fields:    record.Fields(),
+	}
+}
+
+// templateErrorFormat is a format of the marker appended to the partial output
+// of the template that could not be executed.
+const templateErrorFormat = "%%!(TEMPLATE ERROR: %v)"
+
+// TemplateError returns marker of the template execution error, it is appended
+// to the partial output, so log record never disappears silently, e.g.
+// "%!(TEMPLATE ERROR: ...)".
+func TemplateError(err error) string {
+	return fmt.Sprintf(templateErrorFormat, err)
+}
+
+// TemplateFunctions returns helper functions available in the text/template
+// based formatters. The colorize function is used by the "color" helper to
+// wrap text into the color of the level.
+func TemplateFunctions(colorize func(logLevel level.Level, text string) string) template.FuncMap {
+	return template.FuncMap{
+		"color": func(logLevel level.Level, value interface{}) string {
+			return colorize(logLevel, fmt.Sprint(value))
+		},
+		"upper": func(value interface{}) string {
+			return strings.ToUpper(fmt.Sprint(value))
+		},
+		"pad": func(width int, value interface{}) string {
+			text := fmt.Sprint(value)
+			padding := width
+			if padding < 0 {
+				padding = -padding
+			}
+			padding -= utf8.RuneCountInString(text)
+			if padding <= 0 {
+				return text
+			}
+			if width < 0 {
+				return strings.Repeat(" ", padding) + text
+			}
+			return text + strings.Repeat(" ", padding)
+		},
+		"json": func(value interface{}) (string, error) {
+			data, err := json.Marshal(value)
+			return string(data), err
+		},
+		"since": func(start time.Time) time.Duration {
+			return time.Since(start)
+		},
+		"level": func(name string) level.Level {
+			return level.ParseLevel(strings.ToLower(name))
+		},
+	}
+}
+
+// TemplateUsesField returns true, if parsed template (including templates
+// defined in it) could read the field of the TemplateRecord, e.g. "Caller".
+// Template that passes the whole record (dot or "$") anywhere, e.g.
+// "{{json .}}", is considered to use all fields.
+func TemplateUsesField(parsed *template.Template, field string) bool {
+	for _, defined := range parsed.Templates() {
+		if defined.Tree != nil && nodeUsesField(defined.Tree.Root, field) {
+			return true
+		}
+	}
+	return false
+}
+
+// nodeUsesField returns true, if node of the template tree references the field
+// of the TemplateRecord or the whole record.
+func nodeUsesField(node parse.Node, field string) bool {
+	switch node := node.(type) {
+	case *parse.ListNode:
+		if node == nil {
+			return false
+		}
+		for _, child := range node.Nodes {
+			if nodeUsesField(child, field) {
+				return true
+			}
+		}
+	case *parse.ActionNode:
+		return nodeUsesField(node.Pipe, field)
+	case *parse.PipeNode:
+		if node == nil {
+			return false
+		}
+		for _, command := range node.Cmds {
+			if nodeUsesField(command, field) {
+				return true
+			}
+		}
+	case *parse.CommandNode:
+		for _, argument := range node.Args {
+			if nodeUsesField(argument, field) {
+				return true
+			}
+		}
+	case *parse.ChainNode:
+		return nodeUsesField(node.Node, field)
+	case *parse.FieldNode:
+		return node.Ident[0] == field
+	case *parse.VariableNode:
+		return node.Ident[0] == "$" && (len(node.Ident) == 1 || node.Ident[1] == field)
+	case *parse.DotNode:
+		return true
+	case *parse.IfNode:
+		return branchUsesField(&node.BranchNode, field)
+	case *parse.RangeNode:
+		return branchUsesField(&node.BranchNode, field)
+	case *parse.WithNode:
+		return branchUsesField(&node.BranchNode, field)
+	case *parse.TemplateNode:
+		return nodeUsesField(node.Pipe, field)
+	}
+	return false
+}
+
+// branchUsesField returns true, if pipeline or any list of the branch node
+// references the field of the TemplateRecord or the whole record.
+func branchUsesField(node *parse.BranchNode, field string) bool {
+	return nodeUsesField(node.Pipe, field) || nodeUsesField(node.List, field) || nodeUsesField(node.ElseList, field)
 }
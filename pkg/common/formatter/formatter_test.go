@@ -2,10 +2,16 @@
 package formatter
 
 import (
+	"errors"
 	"github.com/dl1998/go-logging/internal/testutils"
 	"github.com/dl1998/go-logging/pkg/common/level"
 	"github.com/dl1998/go-logging/pkg/common/logrecord"
+	"os"
+	"strconv"
+	"strings"
 	"testing"
+	"text/template"
+	"time"
 )
 
 var (
@@ -28,9 +34,20 @@ func TestParseKey(t *testing.T) {
 		"Level number":  {key: "%(levelnr)", expected: loggingLevel.DigitRepresentation()},
 		"Date time":     {key: "%(datetime)", expected: record.Time()},
 		"Timestamp":     {key: "%(timestamp)", expected: record.Timestamp()},
+		"Timestamp ms":  {key: "%(timestamp_ms)", expected: record.RawTime().UnixMilli()},
+		"Timestamp us":  {key: "%(timestamp_us)", expected: record.RawTime().UnixMicro()},
+		"Timestamp ns":  {key: "%(timestamp_ns)", expected: record.RawTime().UnixNano()},
+		"RFC3339 nano":  {key: "%(rfc3339nano)", expected: record.RawTime().Format(time.RFC3339Nano)},
 		"Function name": {key: "%(fname)", expected: record.FileName()},
 		"Function line": {key: "%(fline)", expected: record.FileLine()},
+		"Function":      {key: "%(func)", expected: "TestParseKey"},
+		"Package":       {key: "%(package)", expected: "github.com/dl1998/go-logging/pkg/common/formatter"},
+		"Short file":    {key: "%(shortfile)", expected: "formatter_test.go"},
+		"Caller":        {key: "%(caller)", expected: "formatter/formatter_test.go:" + strconv.Itoa(record.FileLine())},
 		"Not a key":     {key: "not a key", expected: "not a key"},
+		"Unknown key":   {key: "%(unknown)", expected: "%(unknown)"},
+		"Specifier":     {key: "%(level:>7)", expected: "  debug"},
+		"Invalid":       {key: "%(level:?)", expected: "%(level:?)"},
 	}
 
 	for name, test := range tests {
@@ -69,3 +86,757 @@ func BenchmarkParseKey(b *testing.B) {
 		})
 	}
 }
+
+// TestParsePlaceholder tests that ParsePlaceholder splits placeholder into name
+// and specifier.
+func TestParsePlaceholder(t *testing.T) {
+	tests := map[string]struct {
+		placeholder string
+		name        string
+		specifier   string
+		ok          bool
+	}{
+		"Without specifier": {placeholder: "%(level)", name: "level", specifier: "", ok: true},
+		"With specifier":    {placeholder: "%(level:-8s)", name: "level", specifier: "-8s", ok: true},
+		"Not a placeholder": {placeholder: "level", name: "", specifier: "", ok: false},
+		"Empty":             {placeholder: "%()", name: "", specifier: "", ok: false},
+		"Empty name":        {placeholder: "%(:8)", name: "", specifier: "", ok: false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			placeholderName, specifier, ok := ParsePlaceholder(test.placeholder)
+
+			testutils.AssertEquals(t, test.name, placeholderName)
+			testutils.AssertEquals(t, test.specifier, specifier)
+			testutils.AssertEquals(t, test.ok, ok)
+		})
+	}
+}
+
+// BenchmarkParsePlaceholder performs benchmarking of the ParsePlaceholder().
+func BenchmarkParsePlaceholder(b *testing.B) {
+	for index := 0; index < b.N; index++ {
+		ParsePlaceholder("%(level:-8s)")
+	}
+}
+
+// TestValidateTemplate tests that ValidateTemplate returns error only for
+// templates with invalid format specifiers.
+func TestValidateTemplate(t *testing.T) {
+	tests := map[string]struct {
+		template string
+		valid    bool
+	}{
+		"Without specifiers": {template: "%(level):%(name):%(message)", valid: true},
+		"Valid specifiers":   {template: "%(level:-8s) %(name:>20) %(fline:04d) %(fname:.30)", valid: true},
+		"Empty specifier":    {template: "%(level:)", valid: false},
+		"Invalid specifier":  {template: "%(level:8z)", valid: false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateTemplate(test.template)
+
+			testutils.AssertEquals(t, test.valid, err == nil)
+		})
+	}
+}
+
+// BenchmarkValidateTemplate performs benchmarking of the ValidateTemplate().
+func BenchmarkValidateTemplate(b *testing.B) {
+	for index := 0; index < b.N; index++ {
+		_ = ValidateTemplate("%(level:-8s) %(name:>20) %(fline:04d) %(fname:.30)")
+	}
+}
+
+// TestParseSpecifier tests that ParseSpecifier parses format specifier.
+func TestParseSpecifier(t *testing.T) {
+	tests := map[string]struct {
+		specifier string
+		expected  *Specifier
+	}{
+		"Left":        {specifier: "-8s", expected: &Specifier{fill: ' ', align: '<', width: 8, precision: -1, verb: 's'}},
+		"Right":       {specifier: ">20", expected: &Specifier{fill: ' ', align: '>', width: 20, precision: -1}},
+		"Fill Center": {specifier: "*^9", expected: &Specifier{fill: '*', align: '^', width: 9, precision: -1}},
+		"Zero":        {specifier: "04d", expected: &Specifier{fill: ' ', zero: true, width: 4, precision: -1, verb: 'd'}},
+		"Precision":   {specifier: ".30", expected: &Specifier{fill: ' ', precision: 30}},
+		"Float":       {specifier: "8.2f", expected: &Specifier{fill: ' ', width: 8, precision: 2, verb: 'f'}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			specifier, err := ParseSpecifier(test.specifier)
+
+			testutils.AssertNil(t, err)
+			testutils.AssertEquals(t, test.expected, specifier)
+		})
+	}
+}
+
+// TestParseSpecifier_Error tests that ParseSpecifier returns error for invalid
+// format specifier.
+func TestParseSpecifier_Error(t *testing.T) {
+	tests := map[string]string{
+		"Empty":              "",
+		"Unknown type":       "8z",
+		"Empty precision":    "8.",
+		"Multiple alignment": "<-8",
+		"Width limit":        ">200000000",
+		"Precision limit":    ".2000",
+		"Width overflow":     "99999999999999999999999",
+	}
+
+	for name, specifier := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseSpecifier(specifier)
+
+			testutils.AssertNotNil(t, err)
+		})
+	}
+}
+
+// BenchmarkParseSpecifier performs benchmarking of the ParseSpecifier().
+func BenchmarkParseSpecifier(b *testing.B) {
+	for index := 0; index < b.N; index++ {
+		_, _ = ParseSpecifier("*^8.2f")
+	}
+}
+
+// TestSpecifier_Format tests that Specifier.Format formats value according to
+// the format specifier.
+func TestSpecifier_Format(t *testing.T) {
+	tests := map[string]struct {
+		specifier string
+		value     interface{}
+		expected  string
+	}{
+		"String default left":   {specifier: "8", value: "info", expected: "info    "},
+		"String left":           {specifier: "-8s", value: "info", expected: "info    "},
+		"String right":          {specifier: ">8", value: "info", expected: "    info"},
+		"String center":         {specifier: "*^8", value: "info", expected: "**info**"},
+		"String truncate":       {specifier: ".3", value: "information", expected: "inf"},
+		"Integer default right": {specifier: "4", value: 12, expected: "  12"},
+		"Integer zero":          {specifier: "04d", value: 12, expected: "0012"},
+		"Negative zero":         {specifier: "05d", value: -12, expected: "-0012"},
+		"Integer as string":     {specifier: "4s", value: 12, expected: "12  "},
+		"Hex":                   {specifier: "X", value: 255, expected: "FF"},
+		"Float":                 {specifier: "8.2f", value: 3.14159, expected: "    3.14"},
+		"Integer as float":      {specifier: ".1f", value: int64(3), expected: "3.0"},
+		"Not a number":          {specifier: "d", value: "text", expected: "text"},
+		"Width smaller":         {specifier: "2", value: "info", expected: "info"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			specifier, err := ParseSpecifier(test.specifier)
+
+			testutils.AssertNil(t, err)
+			testutils.AssertEquals(t, test.expected, specifier.Format(test.value))
+		})
+	}
+}
+
+// BenchmarkSpecifier_Format performs benchmarking of the Specifier.Format().
+func BenchmarkSpecifier_Format(b *testing.B) {
+	specifier, _ := ParseSpecifier("-8s")
+
+	for index := 0; index < b.N; index++ {
+		specifier.Format("info")
+	}
+}
+
+// TestApplySpecifier tests that ApplySpecifier formats value, or returns
+// placeholder if specifier is invalid.
+func TestApplySpecifier(t *testing.T) {
+	testutils.AssertEquals(t, interface{}(1), ApplySpecifier("%(fline)", "", 1))
+	testutils.AssertEquals(t, interface{}("01"), ApplySpecifier("%(fline:02d)", "02d", 1))
+	testutils.AssertEquals(t, interface{}("%(fline:?)"), ApplySpecifier("%(fline:?)", "?", 1))
+}
+
+// BenchmarkApplySpecifier performs benchmarking of the ApplySpecifier().
+func BenchmarkApplySpecifier(b *testing.B) {
+	for index := 0; index < b.N; index++ {
+		ApplySpecifier("%(fline:02d)", "02d", 1)
+	}
+}
+
+// TestCompileTemplate tests that CompileTemplate splits template into literal
+// and placeholder segments.
+func TestCompileTemplate(t *testing.T) {
+	specifier, _ := ParseSpecifier("-8s")
+
+	compiled, err := CompileTemplate("[%(level:-8s)] %(name): %(message)")
+
+	expected := []Segment{
+		{Text: "["},
+		{Text: "%(level:-8s)", Key: "level", Specifier: specifier},
+		{Text: "] "},
+		{Text: "%(name)", Key: "name"},
+		{Text: ": "},
+		{Text: "%(message)", Key: "message"},
+	}
+
+	testutils.AssertNil(t, err)
+	testutils.AssertEquals(t, expected, compiled.Segments())
+}
+
+// TestCompileTemplate_Error tests that CompileTemplate returns error and treats
+// invalid placeholders as literals.
+func TestCompileTemplate_Error(t *testing.T) {
+	compiled, err := CompileTemplate("%(level:8z) %(name)")
+
+	expected := []Segment{
+		{Text: "%(level:8z) "},
+		{Text: "%(name)", Key: "name"},
+	}
+
+	testutils.AssertNotNil(t, err)
+	testutils.AssertEquals(t, expected, compiled.Segments())
+}
+
+// BenchmarkCompileTemplate performs benchmarking of the CompileTemplate().
+func BenchmarkCompileTemplate(b *testing.B) {
+	for index := 0; index < b.N; index++ {
+		_, _ = CompileTemplate("[%(level:-8s)] %(name): %(message)")
+	}
+}
+
+// TestKeyValue tests that KeyValue returns value for the known key.
+func TestKeyValue(t *testing.T) {
+	record := logrecord.New(loggerName, loggingLevel, timeFormat, skipCallers)
+
+	value, ok := KeyValue("name", record)
+
+	testutils.AssertEquals(t, true, ok)
+	testutils.AssertEquals(t, interface{}(loggerName), value)
+
+	_, ok = KeyValue("unknown", record)
+
+	testutils.AssertEquals(t, false, ok)
+}
+
+// BenchmarkKeyValue performs benchmarking of the KeyValue().
+func BenchmarkKeyValue(b *testing.B) {
+	record := logrecord.New(loggerName, loggingLevel, timeFormat, skipCallers)
+
+	for index := 0; index < b.N; index++ {
+		KeyValue("name", record)
+	}
+}
+
+// TestRegisterPlaceholder tests that RegisterPlaceholder registers a new
+// placeholder that could be used in the templates.
+func TestRegisterPlaceholder(t *testing.T) {
+	record := logrecord.New(loggerName, loggingLevel, timeFormat, skipCallers)
+
+	err := RegisterPlaceholder("test-upper-name", func(record logrecord.Interface) any {
+		return strings.ToUpper(record.Name())
+	})
+
+	testutils.AssertNil(t, err)
+	testutils.AssertEquals(t, interface{}("TEST"), ParseKey("%(test-upper-name)", record))
+	testutils.AssertEquals(t, interface{}("TEST  "), ParseKey("%(test-upper-name:6)", record))
+
+	UnregisterPlaceholder("test-upper-name")
+
+	testutils.AssertEquals(t, interface{}("%(test-upper-name)"), ParseKey("%(test-upper-name)", record))
+}
+
+// TestRegisterPlaceholder_Error tests that RegisterPlaceholder returns error
+// for invalid name or missing function.
+func TestRegisterPlaceholder_Error(t *testing.T) {
+	tests := map[string]struct {
+		name     string
+		function PlaceholderFunction
+	}{
+		"Empty name":   {name: "", function: func(logrecord.Interface) any { return nil }},
+		"Invalid name": {name: "a:b", function: func(logrecord.Interface) any { return nil }},
+		"Nil function": {name: "valid", function: nil},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			testutils.AssertNotNil(t, RegisterPlaceholder(test.name, test.function))
+		})
+	}
+}
+
+// BenchmarkRegisterPlaceholder performs benchmarking of the
+// RegisterPlaceholder().
+func BenchmarkRegisterPlaceholder(b *testing.B) {
+	function := func(logrecord.Interface) any { return nil }
+
+	for index := 0; index < b.N; index++ {
+		_ = RegisterPlaceholder("test-benchmark", function)
+	}
+}
+
+// TestRegisterStaticPlaceholder tests that RegisterStaticPlaceholder registers
+// placeholder with the constant value.
+func TestRegisterStaticPlaceholder(t *testing.T) {
+	record := logrecord.New(loggerName, loggingLevel, timeFormat, skipCallers)
+
+	err := RegisterStaticPlaceholder("test-service", "example")
+
+	testutils.AssertNil(t, err)
+	testutils.AssertEquals(t, interface{}("example"), ParseKey("%(test-service)", record))
+
+	UnregisterPlaceholder("test-service")
+}
+
+// BenchmarkRegisterStaticPlaceholder performs benchmarking of the
+// RegisterStaticPlaceholder().
+func BenchmarkRegisterStaticPlaceholder(b *testing.B) {
+	for index := 0; index < b.N; index++ {
+		_ = RegisterStaticPlaceholder("test-benchmark", "example")
+	}
+}
+
+// TestNewTemplateRecord tests that NewTemplateRecord copies values from the log
+// record.
+func TestNewTemplateRecord(t *testing.T) {
+	record := logrecord.New(loggerName, loggingLevel, timeFormat, skipCallers)
+
+	data := NewTemplateRecord(record)
+
+	testutils.AssertEquals(t, loggerName, data.Name)
+	testutils.AssertEquals(t, loggingLevel, data.Level)
+	testutils.AssertEquals(t, record.Timestamp(), data.Time.Unix())
+	testutils.AssertEquals(t, record.FileName(), data.Caller.File)
+	testutils.AssertEquals(t, record.FileLine(), data.Caller.Line)
+}
+
+// BenchmarkNewTemplateRecord performs benchmarking of the NewTemplateRecord().
+func BenchmarkNewTemplateRecord(b *testing.B) {
+	record := logrecord.New(loggerName, loggingLevel, timeFormat, skipCallers)
+
+	b.ResetTimer()
+
+	for index := 0; index < b.N; index++ {
+		NewTemplateRecord(record)
+	}
+}
+
+// TestTemplateFunctions tests that helper functions returned by
+// TemplateFunctions produce expected results.
+func TestTemplateFunctions(t *testing.T) {
+	functions := TemplateFunctions(func(logLevel level.Level, text string) string {
+		return "<" + logLevel.String() + ">" + text
+	})
+
+	color := functions["color"].(func(level.Level, interface{}) string)
+	upper := functions["upper"].(func(interface{}) string)
+	pad := functions["pad"].(func(int, interface{}) string)
+	toJSON := functions["json"].(func(interface{}) (string, error))
+	since := functions["since"].(func(time.Time) time.Duration)
+	parseLevel := functions["level"].(func(string) level.Level)
+
+	encoded, err := toJSON(map[string]int{"key": 1})
+
+	testutils.AssertNil(t, err)
+	testutils.AssertEquals(t, "{\"key\":1}", encoded)
+	testutils.AssertEquals(t, "<error>text", color(level.Error, "text"))
+	testutils.AssertEquals(t, "DEBUG", upper(level.Debug))
+	testutils.AssertEquals(t, "ab   ", pad(5, "ab"))
+	testutils.AssertEquals(t, "   ab", pad(-5, "ab"))
+	testutils.AssertEquals(t, "abc", pad(2, "abc"))
+	testutils.AssertEquals(t, true, since(time.Now().Add(-time.Second)) >= time.Second)
+	testutils.AssertEquals(t, level.Error, parseLevel("ERROR"))
+}
+
+// BenchmarkTemplateFunctions performs benchmarking of the TemplateFunctions().
+func BenchmarkTemplateFunctions(b *testing.B) {
+	colorize := func(_ level.Level, text string) string {
+		return text
+	}
+
+	for index := 0; index < b.N; index++ {
+		TemplateFunctions(colorize)
+	}
+}
+
+// TestTemplateError tests that TemplateError returns marker of the template
+// execution error.
+func TestTemplateError(t *testing.T) {
+	testutils.AssertEquals(t, "%!(TEMPLATE ERROR: failed)", TemplateError(errors.New("failed")))
+}
+
+// TestTemplateUsesField tests that TemplateUsesField detects references to the
+// field and templates passing the whole record.
+func TestTemplateUsesField(t *testing.T) {
+	tests := map[string]struct {
+		text     string
+		expected bool
+	}{
+		"Field":            {"{{.Caller.File}}", true},
+		"Other Field":      {"{{.Message}}", false},
+		"Text":             {"Caller: {{.Message}} .Caller", false},
+		"Whole Record":     {"{{json .}}", true},
+		"Root Variable":    {"{{$.Caller.Line}}", true},
+		"Root Record":      {"{{with $}}{{end}}", true},
+		"Branch":           {"{{if .Message}}{{else}}{{.Caller.Line}}{{end}}", true},
+		"With":             {"{{with .Caller}}{{end}}", true},
+		"Chain":            {"{{(.Caller).File}}", true},
+		"Defined Template": {`{{define "caller"}}{{.Caller.File}}{{end}}{{.Message}}`, true},
+		"Variable":         {"{{$message := .Message}}{{$message}}", false},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			parsed := template.Must(template.New(name).Funcs(TemplateFunctions(nil)).Parse(test.text))
+
+			testutils.AssertEquals(t, test.expected, TemplateUsesField(parsed, "Caller"))
+		})
+	}
+}
+
+// BenchmarkTemplateUsesField performs benchmarking of the TemplateUsesField().
+func BenchmarkTemplateUsesField(b *testing.B) {
+	parsed := template.Must(template.New("template").Parse("{{.Time}} {{.Level}} {{.Message}}"))
+
+	for index := 0; index < b.N; index++ {
+		TemplateUsesField(parsed, "Caller")
+	}
+}
+
+// TestSplitFunctionName tests that SplitFunctionName splits function name into
+// the package path and function name.
+func TestSplitFunctionName(t *testing.T) {
+	tests := map[string]struct {
+		name            string
+		expectedPackage string
+		expectedName    string
+	}{
+		"Function":      {"github.com/user/module/package.Function", "github.com/user/module/package", "Function"},
+		"Method":        {"github.com/user/module/package.(*Type).Method", "github.com/user/module/package", "(*Type).Method"},
+		"Main":          {"main.main", "main", "main"},
+		"Dotted module": {"gopkg.in/yaml%2ev3.Marshal", "gopkg.in/yaml.v3", "Marshal"},
+		"Empty":         {"", "", ""},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			packageName, functionName := SplitFunctionName(test.name)
+
+			testutils.AssertEquals(t, test.expectedPackage, packageName)
+			testutils.AssertEquals(t, test.expectedName, functionName)
+		})
+	}
+}
+
+// BenchmarkSplitFunctionName performs benchmarking of the SplitFunctionName().
+func BenchmarkSplitFunctionName(b *testing.B) {
+	for index := 0; index < b.N; index++ {
+		SplitFunctionName("github.com/user/module/package.(*Type).Method")
+	}
+}
+
+// TestShortFile tests that ShortFile trims configured path prefix or returns
+// base name of the file.
+func TestShortFile(t *testing.T) {
+	defer SetPathPrefix("")
+
+	testutils.AssertEquals(t, "main.go", ShortFile("/build/module/cmd/app/main.go"))
+
+	SetPathPrefix("/build/module")
+
+	testutils.AssertEquals(t, "cmd/app/main.go", ShortFile("/build/module/cmd/app/main.go"))
+	testutils.AssertEquals(t, "main.go", ShortFile("/other/cmd/app/main.go"))
+}
+
+// BenchmarkShortFile performs benchmarking of the ShortFile().
+func BenchmarkShortFile(b *testing.B) {
+	for index := 0; index < b.N; index++ {
+		ShortFile("/build/module/cmd/app/main.go")
+	}
+}
+
+// TestCaller tests that Caller returns short representation of the place in
+// the code.
+func TestCaller(t *testing.T) {
+	defer SetPathPrefix("")
+
+	testutils.AssertEquals(t, "app/main.go:12", Caller("/build/module/cmd/app/main.go", 12))
+
+	SetPathPrefix("/build/module/")
+
+	testutils.AssertEquals(t, "cmd/app/main.go:12", Caller("/build/module/cmd/app/main.go", 12))
+	testutils.AssertEquals(t, "/build/module/", PathPrefix())
+}
+
+// BenchmarkCaller performs benchmarking of the Caller().
+func BenchmarkCaller(b *testing.B) {
+	for index := 0; index < b.N; index++ {
+		Caller("/build/module/cmd/app/main.go", 12)
+	}
+}
+
+// TestPlaceholderUsesCaller tests that PlaceholderUsesCaller reports whether
+// placeholder depends on the caller information.
+func TestPlaceholderUsesCaller(t *testing.T) {
+	_ = RegisterStaticPlaceholder("test-static", "value")
+	_ = RegisterPlaceholder("test-dynamic", func(record logrecord.Interface) any {
+		return record.FileName()
+	})
+	defer UnregisterPlaceholder("test-static")
+	defer UnregisterPlaceholder("test-dynamic")
+
+	tests := map[string]struct {
+		name     string
+		expected bool
+	}{
+		"Level":     {name: "level", expected: false},
+		"Timestamp": {name: "timestamp_ms", expected: false},
+		"File name": {name: "fname", expected: true},
+		"Caller":    {name: "caller", expected: true},
+		"Static":    {name: "test-static", expected: false},
+		"Dynamic":   {name: "test-dynamic", expected: true},
+		"Unknown":   {name: "unknown", expected: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			testutils.AssertEquals(t, test.expected, PlaceholderUsesCaller(test.name))
+		})
+	}
+}
+
+// BenchmarkPlaceholderUsesCaller performs benchmarking of the
+// PlaceholderUsesCaller().
+func BenchmarkPlaceholderUsesCaller(b *testing.B) {
+	for index := 0; index < b.N; index++ {
+		PlaceholderUsesCaller("fname")
+	}
+}
+
+// TestTemplate_UsesCaller tests that Template.UsesCaller reports whether any
+// placeholder of the template depends on the caller information.
+func TestTemplate_UsesCaller(t *testing.T) {
+	tests := map[string]struct {
+		template string
+		ignored  []string
+		expected bool
+	}{
+		"Without caller": {template: "%(level):%(name)", expected: false},
+		"With caller":    {template: "%(level):%(fline:04d)", expected: true},
+		"Ignored":        {template: "%(level):%(message)", ignored: []string{"message"}, expected: false},
+		"Not ignored":    {template: "%(level):%(message)", expected: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			compiled, _ := CompileTemplate(test.template)
+
+			testutils.AssertEquals(t, test.expected, compiled.UsesCaller(test.ignored...))
+		})
+	}
+}
+
+// BenchmarkTemplate_UsesCaller performs benchmarking of the
+// Template.UsesCaller().
+func BenchmarkTemplate_UsesCaller(b *testing.B) {
+	compiled, _ := CompileTemplate("%(level):%(name):%(message)")
+
+	for index := 0; index < b.N; index++ {
+		compiled.UsesCaller("message")
+	}
+}
+
+// callerUser is a test implementation of the CallerUser.
+type callerUser bool
+
+// UsesCaller returns value of the callerUser.
+func (user callerUser) UsesCaller() bool {
+	return bool(user)
+}
+
+// TestUsesCaller tests that UsesCaller considers formatters that do not
+// implement CallerUser as using caller information.
+func TestUsesCaller(t *testing.T) {
+	testutils.AssertEquals(t, true, UsesCaller(callerUser(true)))
+	testutils.AssertEquals(t, false, UsesCaller(callerUser(false)))
+	testutils.AssertEquals(t, true, UsesCaller("not a formatter"))
+}
+
+// TestParseKey_Stack tests that ParseKey returns formatted stack for the
+// "%(stack)" placeholder.
+func TestParseKey_Stack(t *testing.T) {
+	record := logrecord.New(loggerName, loggingLevel, timeFormat, skipCallers, logrecord.WithStack(true))
+
+	testutils.AssertEquals(t, interface{}(record.Stack().String()), ParseKey("%(stack)", record))
+	testutils.AssertEquals(t, false, PlaceholderUsesCaller("stack"))
+}
+
+// TestParseKey_Process tests that ParseKey returns process and host metadata
+// for the corresponding placeholders.
+func TestParseKey_Process(t *testing.T) {
+	record := logrecord.New(loggerName, loggingLevel, timeFormat, skipCallers, logrecord.WithGoroutineID(true))
+	expectedHostname, _ := os.Hostname()
+
+	tests := map[string]any{
+		"%(hostname)":  expectedHostname,
+		"%(pid)":       os.Getpid(),
+		"%(process)":   processName(),
+		"%(goroutine)": record.GoroutineID(),
+		"%(uptime)":    record.Uptime(),
+	}
+
+	for key, expected := range tests {
+		t.Run(key, func(t *testing.T) {
+			testutils.AssertEquals(t, expected, ParseKey(key, record))
+			testutils.AssertEquals(t, false, PlaceholderUsesCaller(key[2:len(key)-1]))
+		})
+	}
+}
+
+// BenchmarkParseKey_Process benchmarks the ParseKey function for process and
+// host metadata placeholders.
+func BenchmarkParseKey_Process(b *testing.B) {
+	record := logrecord.New(loggerName, loggingLevel, timeFormat, skipCallers)
+
+	for _, key := range []string{"%(hostname)", "%(pid)", "%(process)", "%(uptime)"} {
+		b.Run(key, func(b *testing.B) {
+			for index := 0; index < b.N; index++ {
+				ParseKey(key, record)
+			}
+		})
+	}
+}
+
+// goroutineUser is a test implementation of the GoroutineUser.
+type goroutineUser bool
+
+// UsesGoroutine returns value of the goroutineUser.
+func (user goroutineUser) UsesGoroutine() bool {
+	return bool(user)
+}
+
+// TestUsesGoroutine tests that UsesGoroutine considers formatters that do not
+// implement GoroutineUser as not using identifier of the goroutine.
+func TestUsesGoroutine(t *testing.T) {
+	testutils.AssertEquals(t, true, UsesGoroutine(goroutineUser(true)))
+	testutils.AssertEquals(t, false, UsesGoroutine(goroutineUser(false)))
+	testutils.AssertEquals(t, false, UsesGoroutine("not a formatter"))
+}
+
+// TestTemplate_Uses tests that Template.Uses reports whether template contains
+// placeholder.
+func TestTemplate_Uses(t *testing.T) {
+	compiled, _ := CompileTemplate("%(level):[%(goroutine)] %(message)")
+
+	testutils.AssertEquals(t, true, compiled.Uses(GoroutinePlaceholder))
+	testutils.AssertEquals(t, false, compiled.Uses("pid"))
+}
+
+// TestParseKey_Elapsed tests that ParseKey returns relative and delta time in
+// milliseconds.
+func TestParseKey_Elapsed(t *testing.T) {
+	clock := logrecord.NewClock()
+	logrecord.New(loggerName, loggingLevel, timeFormat, skipCallers, logrecord.WithClock(clock))
+	record := logrecord.New(loggerName, loggingLevel, timeFormat, skipCallers, logrecord.WithClock(clock))
+
+	testutils.AssertEquals(t, any(float64(record.Relative())/float64(time.Millisecond)), ParseKey("%(relative)", record))
+	testutils.AssertEquals(t, any(float64(record.Delta())/float64(time.Millisecond)), ParseKey("%(delta)", record))
+	testutils.AssertEquals(t, false, PlaceholderUsesCaller("relative"))
+	testutils.AssertEquals(t, false, PlaceholderUsesCaller("delta"))
+}
+
+// TestMilliseconds tests that milliseconds converts duration to the fractional
+// number of milliseconds.
+func TestMilliseconds(t *testing.T) {
+	testutils.AssertEquals(t, 1.5, milliseconds(1500*time.Microsecond))
+}
+
+// TestCompileTemplate_Field tests that CompileTemplate splits specifier of the
+// field placeholder into the name of the field and format specifier.
+func TestCompileTemplate_Field(t *testing.T) {
+	specifier, _ := ParseSpecifier("-6s")
+
+	compiled, err := CompileTemplate("%(field:request_id) %(field:user:-6s)")
+
+	expected := []Segment{
+		{Text: "%(field:request_id)", Key: FieldPlaceholder, Field: "request_id"},
+		{Text: " "},
+		{Text: "%(field:user:-6s)", Key: FieldPlaceholder, Field: "user", Specifier: specifier},
+	}
+
+	testutils.AssertNil(t, err)
+	testutils.AssertEquals(t, expected, compiled.Segments())
+}
+
+// TestCompileTemplate_FieldError tests that CompileTemplate returns error, if
+// field placeholder does not contain name of the field.
+func TestCompileTemplate_FieldError(t *testing.T) {
+	compiled, err := CompileTemplate("%(field) %(field::-6s)")
+
+	expected := []Segment{
+		{Text: "%(field) %(field::-6s)"},
+	}
+
+	testutils.AssertNotNil(t, err)
+	testutils.AssertEquals(t, expected, compiled.Segments())
+}
+
+// TestSegmentValue tests that SegmentValue returns value of the bound field for
+// the field placeholder and value of the key for other placeholders.
+func TestSegmentValue(t *testing.T) {
+	fields := map[string]interface{}{"request_id": "abc"}
+	record := logrecord.New(loggerName, loggingLevel, timeFormat, skipCallers, logrecord.WithFields(fields))
+
+	tests := map[string]struct {
+		segment  Segment
+		expected interface{}
+		ok       bool
+	}{
+		"Field":        {Segment{Key: FieldPlaceholder, Field: "request_id"}, "abc", true},
+		"MissingField": {Segment{Key: FieldPlaceholder, Field: "user"}, "", true},
+		"Key":          {Segment{Key: "name"}, loggerName, true},
+		"UnknownKey":   {Segment{Key: "unknown"}, nil, false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			value, ok := SegmentValue(test.segment, record)
+
+			testutils.AssertEquals(t, test.ok, ok)
+			testutils.AssertEquals(t, test.expected, value)
+		})
+	}
+}
+
+// BenchmarkSegmentValue performs benchmarking of the SegmentValue().
+func BenchmarkSegmentValue(b *testing.B) {
+	segment := Segment{Key: FieldPlaceholder, Field: "request_id"}
+	record := logrecord.New(loggerName, loggingLevel, timeFormat, skipCallers, logrecord.WithFields(map[string]interface{}{"request_id": "abc"}))
+
+	for index := 0; index < b.N; index++ {
+		SegmentValue(segment, record)
+	}
+}
+
+// TestParseKey_Field tests that ParseKey returns value of the bound field and
+// applies format specifier to it.
+func TestParseKey_Field(t *testing.T) {
+	fields := map[string]interface{}{"request_id": "abc", "attempt": 3}
+	record := logrecord.New(loggerName, loggingLevel, timeFormat, skipCallers, logrecord.WithFields(fields))
+
+	testutils.AssertEquals(t, any("abc"), ParseKey("%(field:request_id)", record))
+	testutils.AssertEquals(t, any("003"), ParseKey("%(field:attempt:03d)", record))
+	testutils.AssertEquals(t, any(""), ParseKey("%(field:user)", record))
+	testutils.AssertEquals(t, any("%(field)"), ParseKey("%(field)", record))
+	testutils.AssertEquals(t, false, PlaceholderUsesCaller(FieldPlaceholder))
+}
+
+// TestParseKey_Tracing tests that ParseKey returns identifiers of the span
+// bound to the logger.
+func TestParseKey_Tracing(t *testing.T) {
+	fields := map[string]interface{}{"trace_id": "4bf92f3577b34da6a3ce929d0e0e4736", "span_id": "00f067aa0ba902b7"}
+	record := logrecord.New(loggerName, loggingLevel, timeFormat, skipCallers, logrecord.WithFields(fields))
+
+	testutils.AssertEquals(t, any("4bf92f3577b34da6a3ce929d0e0e4736"), ParseKey("%(trace_id)", record))
+	testutils.AssertEquals(t, any("00f067aa0ba902b7"), ParseKey("%(span_id)", record))
+	testutils.AssertEquals(t, any(""), ParseKey("%(trace_flags)", record))
+	testutils.AssertEquals(t, false, PlaceholderUsesCaller("trace_id"))
+	testutils.AssertEquals(t, false, PlaceholderUsesCaller("span_id"))
+	testutils.AssertEquals(t, false, PlaceholderUsesCaller("trace_flags"))
+}
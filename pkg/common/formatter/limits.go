@@ -0,0 +1,206 @@
+package formatter
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"unicode/utf8"
+)
+
+// TruncatedKey is a key of the map element that replaces truncated elements of
+// the map.
+const TruncatedKey = "…"
+
+// truncatedBytesFormat is a format of the marker appended to the truncated
+// strings.
+const truncatedBytesFormat = "…(truncated %d bytes)"
+
+// truncatedElementsFormat is a format of the marker that replaces truncated
+// elements of the collections.
+const truncatedElementsFormat = "…(truncated %d elements)"
+
+// Limits defines limits of the user-supplied values (messages and parameters)
+// of the log records. Zero value of the limit means that it is not limited.
+type Limits struct {
+	// MaxValueLength is a maximum length of the string value in bytes, longer
+	// strings are cut and end with "…(truncated N bytes)" marker.
+	MaxValueLength int
+	// MaxCollectionElements is a maximum number of elements of the slices,
+	// arrays and maps, the rest of elements is replaced with
+	// "…(truncated N elements)" marker.
+	MaxCollectionElements int
+	// MaxDepth is a maximum nesting depth of the collections, deeper collections
+	// are replaced with "…(truncated N elements)" marker.
+	MaxDepth int
+	// MaxRecordSize is a maximum size of the formatted log record in bytes.
+	MaxRecordSize int
+}
+
+// IsZero returns true, if none of the limits is set.
+func (limits Limits) IsZero() bool {
+	return limits == Limits{}
+}
+
+// LimitString cuts text to the MaxValueLength. It returns true, if text was
+// truncated.
+func (limits Limits) LimitString(text string) (string, bool) {
+	if limits.MaxValueLength <= 0 || len(text) <= limits.MaxValueLength {
+		return text, false
+	}
+	cut := runeStart(text, limits.MaxValueLength)
+	return text[:cut] + TruncatedBytes(len(text)-cut), true
+}
+
+// TruncatedBytes returns marker of the value that was truncated by count
+// bytes, e.g. "…(truncated 10 bytes)".
+func TruncatedBytes(count int) string {
+	return fmt.Sprintf(truncatedBytesFormat, count)
+}
+
+// LimitValue applies limits to the value: strings are cut to the
+// MaxValueLength, slices, arrays and maps are cut to the MaxCollectionElements
+// and MaxDepth. Truncated collections are converted to []interface{} and
+// map[string]interface{} respectively. It returns true, if value was truncated.
+func (limits Limits) LimitValue(value any) (any, bool) {
+	return limits.limitValue(value, 1)
+}
+
+// FitRecord cuts formatted log record to the MaxRecordSize, so it ends with
+// "…(truncated N bytes)" marker and does not exceed limit. It returns true, if
+// record was truncated.
+func (limits Limits) FitRecord(record string) (string, bool) {
+	size := limits.MaxRecordSize
+	if size <= 0 || len(record) <= size {
+		return record, false
+	}
+	cut := runeStart(record, size)
+	for cut > 0 {
+		marker := TruncatedBytes(len(record) - cut)
+		if cut+len(marker) <= size {
+			break
+		}
+		cut = runeStart(record, max(size-len(marker), 0))
+	}
+	return record[:cut] + TruncatedBytes(len(record)-cut), true
+}
+
+// limitValue applies limits to the value located at the depth.
+func (limits Limits) limitValue(value any, depth int) (any, bool) {
+	if text, ok := value.(string); ok {
+		return limits.LimitString(text)
+	}
+	if limits.MaxValueLength <= 0 && limits.MaxCollectionElements <= 0 && limits.MaxDepth <= 0 {
+		return value, false
+	}
+
+	reflected := reflect.ValueOf(value)
+	switch reflected.Kind() {
+	case reflect.Slice, reflect.Array:
+		if reflected.Type().Elem().Kind() == reflect.Uint8 {
+			return value, false
+		}
+		if limits.MaxDepth > 0 && depth > limits.MaxDepth {
+			return fmt.Sprintf(truncatedElementsFormat, reflected.Len()), true
+		}
+		return limits.limitSlice(reflected, depth)
+	case reflect.Map:
+		if limits.MaxDepth > 0 && depth > limits.MaxDepth {
+			return fmt.Sprintf(truncatedElementsFormat, reflected.Len()), true
+		}
+		return limits.limitMap(reflected, depth)
+	default:
+		return value, false
+	}
+}
+
+// limitSlice applies limits to the elements of the slice or array.
+func (limits Limits) limitSlice(reflected reflect.Value, depth int) (any, bool) {
+	length := reflected.Len()
+	kept := length
+	if limits.MaxCollectionElements > 0 && length > limits.MaxCollectionElements {
+		kept = limits.MaxCollectionElements
+	}
+
+	truncated := kept < length
+	elements := make([]interface{}, kept, kept+1)
+	for index := 0; index < kept; index++ {
+		element, elementTruncated := limits.limitValue(reflected.Index(index).Interface(), depth+1)
+		elements[index] = element
+		truncated = truncated || elementTruncated
+	}
+
+	if !truncated {
+		return reflected.Interface(), false
+	}
+	if kept < length {
+		elements = append(elements, fmt.Sprintf(truncatedElementsFormat, length-kept))
+	}
+	return elements, true
+}
+
+// limitMap applies limits to the elements of the map, elements are kept in the
+// order of their sorted keys.
+func (limits Limits) limitMap(reflected reflect.Value, depth int) (any, bool) {
+	keys := make([]string, 0, reflected.Len())
+	values := make(map[string]reflect.Value, reflected.Len())
+	for iterator := reflected.MapRange(); iterator.Next(); {
+		key := fmt.Sprint(iterator.Key().Interface())
+		keys = append(keys, key)
+		values[key] = iterator.Value()
+	}
+	sort.Strings(keys)
+
+	kept := len(keys)
+	if limits.MaxCollectionElements > 0 && kept > limits.MaxCollectionElements {
+		kept = limits.MaxCollectionElements
+	}
+
+	truncated := kept < len(keys)
+	elements := make(map[string]interface{}, kept+1)
+	for _, key := range keys[:kept] {
+		element, elementTruncated := limits.limitValue(values[key].Interface(), depth+1)
+		elements[key] = element
+		truncated = truncated || elementTruncated
+	}
+
+	if !truncated {
+		return reflected.Interface(), false
+	}
+	if kept < len(keys) {
+		elements[TruncatedKey] = fmt.Sprintf(truncatedElementsFormat, len(keys)-kept)
+	}
+	return elements, true
+}
+
+// runeStart returns the largest index that is not greater than index and does
+// not split UTF-8 encoded character of the text.
+func runeStart(text string, index int) int {
+	for index > 0 && index < len(text) && !utf8.RuneStart(text[index]) {
+		index--
+	}
+	return index
+}
+
+// Limitable is an optional interface of the formatters, it allows to configure
+// limits of the values.
+type Limitable interface {
+	Limits() Limits
+	SetLimits(limits Limits)
+}
+
+// Limitation contains limits of the formatter, it is embedded by formatters to
+// implement Limitable interface.
+type Limitation struct {
+	// limits are limits of the values.
+	limits Limits
+}
+
+// Limits returns limits of the formatter.
+func (limitation *Limitation) Limits() Limits {
+	return limitation.limits
+}
+
+// SetLimits sets limits of the formatter.
+func (limitation *Limitation) SetLimits(limits Limits) {
+	limitation.limits = limits
+}
@@ -0,0 +1,156 @@
+package formatter
+
+import (
+	"github.com/dl1998/go-logging/internal/testutils"
+	"strings"
+	"testing"
+)
+
+// TestLimits_IsZero tests that Limits.IsZero returns true only if none of the
+// limits is set.
+func TestLimits_IsZero(t *testing.T) {
+	testutils.AssertEquals(t, true, Limits{}.IsZero())
+	testutils.AssertEquals(t, false, Limits{MaxDepth: 1}.IsZero())
+}
+
+// TestLimits_LimitString tests that Limits.LimitString cuts long strings and
+// appends truncation marker without splitting of the characters.
+func TestLimits_LimitString(t *testing.T) {
+	tests := map[string]struct {
+		limits    Limits
+		text      string
+		expected  string
+		truncated bool
+	}{
+		"Unlimited": {limits: Limits{}, text: "abcdef", expected: "abcdef"},
+		"Short":     {limits: Limits{MaxValueLength: 6}, text: "abcdef", expected: "abcdef"},
+		"Long":      {limits: Limits{MaxValueLength: 3}, text: "abcdef", expected: "abc…(truncated 3 bytes)", truncated: true},
+		"Unicode":   {limits: Limits{MaxValueLength: 3}, text: "ääää", expected: "ä…(truncated 6 bytes)", truncated: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			text, truncated := test.limits.LimitString(test.text)
+
+			testutils.AssertEquals(t, test.expected, text)
+			testutils.AssertEquals(t, test.truncated, truncated)
+		})
+	}
+}
+
+// BenchmarkLimits_LimitString benchmarks the Limits.LimitString method.
+func BenchmarkLimits_LimitString(b *testing.B) {
+	limits := Limits{MaxValueLength: 16}
+	text := strings.Repeat("a", 1024)
+
+	for index := 0; index < b.N; index++ {
+		limits.LimitString(text)
+	}
+}
+
+// TestLimits_LimitValue tests that Limits.LimitValue cuts strings, number of
+// elements and depth of the collections.
+func TestLimits_LimitValue(t *testing.T) {
+	tests := map[string]struct {
+		limits    Limits
+		value     any
+		expected  any
+		truncated bool
+	}{
+		"Number": {
+			limits:   Limits{MaxValueLength: 1, MaxCollectionElements: 1, MaxDepth: 1},
+			value:    12345,
+			expected: 12345,
+		},
+		"Bytes": {
+			limits:   Limits{MaxCollectionElements: 1},
+			value:    []byte("abc"),
+			expected: []byte("abc"),
+		},
+		"Slice Within Limits": {
+			limits:   Limits{MaxCollectionElements: 3},
+			value:    []int{1, 2, 3},
+			expected: []int{1, 2, 3},
+		},
+		"Slice Elements": {
+			limits:    Limits{MaxCollectionElements: 2},
+			value:     []int{1, 2, 3, 4},
+			expected:  []interface{}{1, 2, "…(truncated 2 elements)"},
+			truncated: true,
+		},
+		"Slice Strings": {
+			limits:    Limits{MaxValueLength: 2},
+			value:     []string{"ab", "abc"},
+			expected:  []interface{}{"ab", "ab…(truncated 1 bytes)"},
+			truncated: true,
+		},
+		"Map Elements": {
+			limits:    Limits{MaxCollectionElements: 1},
+			value:     map[string]int{"b": 2, "a": 1},
+			expected:  map[string]interface{}{"a": 1, TruncatedKey: "…(truncated 1 elements)"},
+			truncated: true,
+		},
+		"Depth": {
+			limits:    Limits{MaxDepth: 2},
+			value:     map[string]interface{}{"a": []interface{}{1, []int{2, 3}}},
+			expected:  map[string]interface{}{"a": []interface{}{1, "…(truncated 2 elements)"}},
+			truncated: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			value, truncated := test.limits.LimitValue(test.value)
+
+			testutils.AssertEquals(t, test.expected, value)
+			testutils.AssertEquals(t, test.truncated, truncated)
+		})
+	}
+}
+
+// BenchmarkLimits_LimitValue benchmarks the Limits.LimitValue method.
+func BenchmarkLimits_LimitValue(b *testing.B) {
+	limits := Limits{MaxValueLength: 16, MaxCollectionElements: 8, MaxDepth: 2}
+	value := map[string]interface{}{"a": []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, "b": strings.Repeat("a", 32)}
+
+	for index := 0; index < b.N; index++ {
+		limits.LimitValue(value)
+	}
+}
+
+// TestLimits_FitRecord tests that Limits.FitRecord cuts formatted log record,
+// so it does not exceed MaxRecordSize including the truncation marker.
+func TestLimits_FitRecord(t *testing.T) {
+	limits := Limits{MaxRecordSize: 30}
+
+	record, truncated := limits.FitRecord(strings.Repeat("a", 100))
+
+	testutils.AssertEquals(t, true, truncated)
+	testutils.AssertEquals(t, "aaaaaaa…(truncated 93 bytes)", record)
+	testutils.AssertEquals(t, true, len(record) <= limits.MaxRecordSize)
+
+	record, truncated = limits.FitRecord("short")
+
+	testutils.AssertEquals(t, false, truncated)
+	testutils.AssertEquals(t, "short", record)
+}
+
+// BenchmarkLimits_FitRecord benchmarks the Limits.FitRecord method.
+func BenchmarkLimits_FitRecord(b *testing.B) {
+	limits := Limits{MaxRecordSize: 64}
+	record := strings.Repeat("a", 1024)
+
+	for index := 0; index < b.N; index++ {
+		limits.FitRecord(record)
+	}
+}
+
+// TestLimitation tests that Limitation stores limits of the formatter.
+func TestLimitation(t *testing.T) {
+	limitation := &Limitation{}
+	limits := Limits{MaxValueLength: 10}
+
+	limitation.SetLimits(limits)
+
+	testutils.AssertEquals(t, limits, limitation.Limits())
+}
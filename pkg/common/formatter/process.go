@@ -0,0 +1,30 @@
+package formatter
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// pid is an identifier of the current process.
+var pid = os.Getpid()
+
+// hostname returns name of the host, it is resolved only once. It returns
+// empty string if name could not be resolved.
+var hostname = sync.OnceValue(func() string {
+	name, err := os.Hostname()
+	if err != nil {
+		return ""
+	}
+	return name
+})
+
+// processName returns name of the executable of the current process, it is
+// resolved only once.
+var processName = sync.OnceValue(func() string {
+	executable, err := os.Executable()
+	if err != nil {
+		executable = os.Args[0]
+	}
+	return filepath.Base(executable)
+})
@@ -0,0 +1,213 @@
+package formatter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// SanitizeMode defines how control characters are neutralized in the
+// user-supplied values (messages and parameters) of the log records.
+type SanitizeMode int
+
+const (
+	// SanitizeDefault leaves decision to the handler: file handlers escape
+	// control characters, other handlers write values as is.
+	SanitizeDefault SanitizeMode = iota
+	// SanitizeNone writes values as is.
+	SanitizeNone
+	// SanitizeEscape replaces control characters with their escape sequences,
+	// e.g. "\n" or "\x1b".
+	SanitizeEscape
+	// SanitizeReplace replaces control characters and ANSI escape sequences with
+	// the SanitizeReplacement.
+	SanitizeReplace
+	// SanitizeStrip removes control characters and ANSI escape sequences.
+	SanitizeStrip
+)
+
+// SanitizeReplacement is a string used instead of control characters by the
+// SanitizeReplace mode.
+const SanitizeReplacement = " "
+
+// sanitizeModeNames maps SanitizeMode values to their names.
+var sanitizeModeNames = map[SanitizeMode]string{
+	SanitizeDefault: "default",
+	SanitizeNone:    "none",
+	SanitizeEscape:  "escape",
+	SanitizeReplace: "replace",
+	SanitizeStrip:   "strip",
+}
+
+// String returns name of the SanitizeMode.
+func (mode SanitizeMode) String() string {
+	if name, ok := sanitizeModeNames[mode]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// ParseSanitizeMode returns SanitizeMode by its name, empty name is parsed as
+// SanitizeDefault. It returns error if name is unknown.
+func ParseSanitizeMode(name string) (SanitizeMode, error) {
+	if name == "" {
+		return SanitizeDefault, nil
+	}
+	for mode, modeName := range sanitizeModeNames {
+		if strings.EqualFold(name, modeName) {
+			return mode, nil
+		}
+	}
+	return SanitizeDefault, fmt.Errorf("unknown sanitize mode %q", name)
+}
+
+// Sanitizable is an optional interface of the formatters, it allows to
+// configure sanitization of the values.
+type Sanitizable interface {
+	SanitizeMode() SanitizeMode
+	SetSanitizeMode(mode SanitizeMode)
+}
+
+// Sanitization contains sanitization mode of the formatter, it is embedded by
+// formatters to implement Sanitizable interface.
+type Sanitization struct {
+	// mode is a sanitization mode of the formatter.
+	mode SanitizeMode
+}
+
+// SanitizeMode returns sanitization mode of the formatter.
+func (sanitization *Sanitization) SanitizeMode() SanitizeMode {
+	return sanitization.mode
+}
+
+// SetSanitizeMode sets sanitization mode of the formatter, SanitizeDefault
+// leaves decision to the handler.
+func (sanitization *Sanitization) SetSanitizeMode(mode SanitizeMode) {
+	sanitization.mode = mode
+}
+
+// SanitizeModeOf returns sanitization mode of the formatter. Formatters that do
+// not implement Sanitizable use SanitizeDefault.
+func SanitizeModeOf(formatter any) SanitizeMode {
+	if sanitizable, ok := formatter.(Sanitizable); ok {
+		return sanitizable.SanitizeMode()
+	}
+	return SanitizeDefault
+}
+
+// Sanitize neutralizes CR/LF, ANSI escape sequences and other control
+// characters in the text according to the mode. Tabs are kept. Text is returned
+// as is, if it does not contain control characters or mode does not sanitize
+// values.
+func Sanitize(text string, mode SanitizeMode) string {
+	if mode < SanitizeEscape || !containsControl(text) {
+		return text
+	}
+
+	var builder strings.Builder
+	builder.Grow(len(text) + 8)
+
+	for index := 0; index < len(text); {
+		character, size := utf8.DecodeRuneInString(text[index:])
+		if !isControl(character) {
+			builder.WriteString(text[index : index+size])
+			index += size
+			continue
+		}
+
+		if mode == SanitizeEscape {
+			builder.WriteString(escapeControl(character))
+			index += size
+			continue
+		}
+
+		index += size + ansiSequenceLength(text[index+size:], character)
+		if mode == SanitizeReplace {
+			builder.WriteString(SanitizeReplacement)
+		}
+	}
+
+	return builder.String()
+}
+
+// isControl returns true, if character is a control character that shall be
+// sanitized.
+func isControl(character rune) bool {
+	return character != '\t' && unicode.IsControl(character)
+}
+
+// containsControl returns true, if text contains control characters.
+func containsControl(text string) bool {
+	for index := 0; index < len(text); index++ {
+		if character := text[index]; (character < 0x20 && character != '\t') || character == 0x7f {
+			return true
+		}
+		if text[index] >= utf8.RuneSelf {
+			return strings.IndexFunc(text[index:], isControl) >= 0
+		}
+	}
+	return false
+}
+
+// escapeControl returns escape sequence of the control character.
+func escapeControl(character rune) string {
+	switch character {
+	case '\n':
+		return `\n`
+	case '\r':
+		return `\r`
+	}
+	quoted := strconv.QuoteRuneToASCII(character)
+	return quoted[1 : len(quoted)-1]
+}
+
+// ansiSequenceLength returns length of the rest of the ANSI escape sequence
+// that starts with the control character followed by the text. It returns 0 if
+// character does not start ANSI escape sequence.
+func ansiSequenceLength(text string, character rune) int {
+	switch {
+	case character == '\u009b':
+		return csiLength(text)
+	case character != '\x1b' || text == "":
+		return 0
+	case text[0] == '[':
+		return 1 + csiLength(text[1:])
+	case text[0] == ']':
+		return 1 + oscLength(text[1:])
+	case text[0] >= 0x40 && text[0] <= 0x5f:
+		return 1
+	}
+	return 0
+}
+
+// csiLength returns length of the parameters and final byte of the Control
+// Sequence Introducer sequence, e.g. "31m" in "\x1b[31m".
+func csiLength(text string) int {
+	for index := 0; index < len(text); index++ {
+		if character := text[index]; character >= 0x40 && character <= 0x7e {
+			return index + 1
+		} else if character < 0x20 || character > 0x3f {
+			return index
+		}
+	}
+	return len(text)
+}
+
+// oscLength returns length of the Operating System Command sequence including
+// its terminator (BEL or ESC \).
+func oscLength(text string) int {
+	for index := 0; index < len(text); index++ {
+		switch text[index] {
+		case '\a':
+			return index + 1
+		case '\x1b':
+			if index+1 < len(text) && text[index+1] == '\\' {
+				return index + 2
+			}
+			return index
+		}
+	}
+	return len(text)
+}
@@ -0,0 +1,104 @@
+package formatter
+
+import (
+	"github.com/dl1998/go-logging/internal/testutils"
+	"testing"
+)
+
+// TestSanitize tests that Sanitize neutralizes control characters and ANSI
+// escape sequences according to the mode.
+func TestSanitize(t *testing.T) {
+	tests := map[string]struct {
+		text     string
+		mode     SanitizeMode
+		expected string
+	}{
+		"Default":            {text: "a\nb", mode: SanitizeDefault, expected: "a\nb"},
+		"None":               {text: "a\nb", mode: SanitizeNone, expected: "a\nb"},
+		"Clean":              {text: "user\tlogged in", mode: SanitizeEscape, expected: "user\tlogged in"},
+		"Escape Newlines":    {text: "a\r\nINFO:forged", mode: SanitizeEscape, expected: `a\r\nINFO:forged`},
+		"Escape ANSI":        {text: "\x1b[31mred\x1b[0m", mode: SanitizeEscape, expected: `\x1b[31mred\x1b[0m`},
+		"Escape C1":          {text: "a\u0085b", mode: SanitizeEscape, expected: `a\u0085b`},
+		"Replace Newlines":   {text: "a\nb", mode: SanitizeReplace, expected: "a" + SanitizeReplacement + "b"},
+		"Replace ANSI":       {text: "\x1b[1;31mred", mode: SanitizeReplace, expected: SanitizeReplacement + "red"},
+		"Strip Newlines":     {text: "a\r\nb", mode: SanitizeStrip, expected: "ab"},
+		"Strip ANSI":         {text: "\x1b[38;5;208mx\x1b[0m", mode: SanitizeStrip, expected: "x"},
+		"Strip OSC":          {text: "\x1b]0;title\ax", mode: SanitizeStrip, expected: "x"},
+		"Strip C1 CSI":       {text: "\u009b31mx", mode: SanitizeStrip, expected: "x"},
+		"Strip Unicode Text": {text: "привет\x00", mode: SanitizeStrip, expected: "привет"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			testutils.AssertEquals(t, test.expected, Sanitize(test.text, test.mode))
+		})
+	}
+}
+
+// BenchmarkSanitize benchmarks the Sanitize function.
+func BenchmarkSanitize(b *testing.B) {
+	benchmarks := map[string]string{
+		"Clean":   "user logged in from 127.0.0.1",
+		"Control": "user logged in\nINFO:forged \x1b[31mred\x1b[0m",
+	}
+
+	for name, text := range benchmarks {
+		b.Run(name, func(b *testing.B) {
+			for index := 0; index < b.N; index++ {
+				Sanitize(text, SanitizeEscape)
+			}
+		})
+	}
+}
+
+// TestParseSanitizeMode tests that ParseSanitizeMode returns mode by its name
+// and error for the unknown name.
+func TestParseSanitizeMode(t *testing.T) {
+	tests := map[string]SanitizeMode{
+		"":        SanitizeDefault,
+		"none":    SanitizeNone,
+		"escape":  SanitizeEscape,
+		"Replace": SanitizeReplace,
+		"STRIP":   SanitizeStrip,
+	}
+
+	for name, expected := range tests {
+		t.Run(name, func(t *testing.T) {
+			mode, err := ParseSanitizeMode(name)
+
+			testutils.AssertNil(t, err)
+			testutils.AssertEquals(t, expected, mode)
+		})
+	}
+
+	_, err := ParseSanitizeMode("unknown")
+
+	testutils.AssertNotNil(t, err)
+}
+
+// TestSanitizeMode_String tests that SanitizeMode.String returns name of the
+// mode.
+func TestSanitizeMode_String(t *testing.T) {
+	testutils.AssertEquals(t, "escape", SanitizeEscape.String())
+	testutils.AssertEquals(t, "unknown", SanitizeMode(-1).String())
+}
+
+// TestSanitization tests that Sanitization stores sanitization mode.
+func TestSanitization(t *testing.T) {
+	sanitization := &Sanitization{}
+
+	testutils.AssertEquals(t, SanitizeDefault, sanitization.SanitizeMode())
+
+	sanitization.SetSanitizeMode(SanitizeStrip)
+
+	testutils.AssertEquals(t, SanitizeStrip, sanitization.SanitizeMode())
+}
+
+// TestSanitizeModeOf tests that SanitizeModeOf returns SanitizeDefault for the
+// formatters that do not implement Sanitizable.
+func TestSanitizeModeOf(t *testing.T) {
+	sanitization := &Sanitization{mode: SanitizeReplace}
+
+	testutils.AssertEquals(t, SanitizeReplace, SanitizeModeOf(sanitization))
+	testutils.AssertEquals(t, SanitizeDefault, SanitizeModeOf("not a formatter"))
+}
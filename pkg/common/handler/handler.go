@@ -3,6 +3,8 @@
 package handler
 
 import (
+	"fmt"
+	"github.com/dl1998/go-logging/pkg/common/formatter"
 	"github.com/dl1998/go-logging/pkg/common/level"
 	"io"
 	"os"
@@ -17,6 +19,55 @@ type Interface interface {
 	SetFromLevel(fromLevel level.Level)
 	ToLevel() level.Level
 	SetToLevel(toLevel level.Level)
+	SanitizeMode() formatter.SanitizeMode
+	SetSanitizeMode(mode formatter.SanitizeMode)
+	ColorOutput() ColorOutput
+	SetColorOutput(output ColorOutput)
+	Colored() bool
+}
+
+// ColorOutput defines whether handler writes colored output.
+type ColorOutput int
+
+const (
+	// ColorAuto writes colored output, if writer is a terminal. It could be
+	// changed by the environment variables: FORCE_COLOR and CLICOLOR_FORCE force
+	// colors, NO_COLOR and CLICOLOR=0 disable them. Detection is done, when the
+	// writer or color output mode is set, not on each write.
+	ColorAuto ColorOutput = iota
+	// ColorAlways always writes colored output.
+	ColorAlways
+	// ColorNever never writes colored output.
+	ColorNever
+)
+
+// colorOutputNames maps ColorOutput values to their names.
+var colorOutputNames = map[ColorOutput]string{
+	ColorAuto:   "auto",
+	ColorAlways: "always",
+	ColorNever:  "never",
+}
+
+// String returns name of the ColorOutput.
+func (output ColorOutput) String() string {
+	if name, ok := colorOutputNames[output]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// ParseColorOutput returns ColorOutput by its name, empty name is parsed as
+// ColorAuto. It returns error if name is unknown.
+func ParseColorOutput(name string) (ColorOutput, error) {
+	if name == "" {
+		return ColorAuto, nil
+	}
+	for output, outputName := range colorOutputNames {
+		if strings.EqualFold(name, outputName) {
+			return output, nil
+		}
+	}
+	return ColorAuto, fmt.Errorf("unknown color output %q", name)
 }
 
 // Handler struct contains information where it shall write log message, how to
@@ -25,17 +76,24 @@ type Handler struct {
 	fromLevel                 level.Level
 	toLevel                   level.Level
 	writer                    io.Writer
+	sanitizeMode              formatter.SanitizeMode
+	colorOutput               ColorOutput
+	supportsColors            bool
 	ConsoleSupportsANSIColors func() bool
 }
 
 // New create a new instance of the Handler.
 func New(fromLevel level.Level, toLevel level.Level, writer io.Writer) *Handler {
-	return &Handler{
-		fromLevel:                 fromLevel,
-		toLevel:                   toLevel,
-		writer:                    writer,
-		ConsoleSupportsANSIColors: consoleSupportsANSIColors,
+	newHandler := &Handler{
+		fromLevel: fromLevel,
+		toLevel:   toLevel,
+		writer:    writer,
+	}
+	newHandler.ConsoleSupportsANSIColors = func() bool {
+		return newHandler.supportsColors
 	}
+	newHandler.detectColors()
+	return newHandler
 }
 
 // Writer returns writer of the Handler.
@@ -46,6 +104,7 @@ func (handler *Handler) Writer() io.Writer {
 // SetWriter sets a new writer for the Handler.
 func (handler *Handler) SetWriter(writer io.Writer) {
 	handler.writer = writer
+	handler.detectColors()
 }
 
 // FromLevel returns log fromLevel of the Handler.
@@ -68,9 +127,76 @@ func (handler *Handler) SetToLevel(toLevel level.Level) {
 	handler.toLevel = toLevel
 }
 
-// consoleSupportsANSIColors returns true, if current terminal supports ANSI
-// colors, otherwise returns False.
-func consoleSupportsANSIColors() bool {
-	term := os.Getenv("TERM")
-	return strings.Contains(term, "xterm") || strings.Contains(term, "color")
+// SanitizeMode returns sanitization mode used by the Handler for the formatters
+// that leave decision to the handler (formatter.SanitizeDefault).
+func (handler *Handler) SanitizeMode() formatter.SanitizeMode {
+	return handler.sanitizeMode
+}
+
+// SetSanitizeMode sets sanitization mode used by the Handler for the formatters
+// that leave decision to the handler (formatter.SanitizeDefault).
+func (handler *Handler) SetSanitizeMode(mode formatter.SanitizeMode) {
+	handler.sanitizeMode = mode
+}
+
+// ColorOutput returns color output mode of the Handler.
+func (handler *Handler) ColorOutput() ColorOutput {
+	return handler.colorOutput
+}
+
+// SetColorOutput sets color output mode of the Handler, it could be used to
+// force colors on (ColorAlways) or off (ColorNever). ColorAuto detects color
+// support of the writer again.
+func (handler *Handler) SetColorOutput(output ColorOutput) {
+	handler.colorOutput = output
+	handler.detectColors()
+}
+
+// Colored returns true, if the Handler shall write colored output.
+func (handler *Handler) Colored() bool {
+	switch handler.colorOutput {
+	case ColorAlways:
+		return true
+	case ColorNever:
+		return false
+	default:
+		return handler.ConsoleSupportsANSIColors()
+	}
+}
+
+// detectColors stores whether writer of the Handler supports colored output, so
+// environment and writer are not checked on each write.
+func (handler *Handler) detectColors() {
+	handler.supportsColors = handler.colorOutput == ColorAuto && consoleSupportsANSIColors(handler.writer)
+}
+
+// consoleSupportsANSIColors returns true, if colored output shall be written to
+// the writer. FORCE_COLOR (unless "0" or "false") and CLICOLOR_FORCE force
+// colors, NO_COLOR and CLICOLOR=0 disable them, otherwise colors are used if
+// writer is a terminal and TERM is not "dumb".
+func consoleSupportsANSIColors(writer io.Writer) bool {
+	if force := os.Getenv("FORCE_COLOR"); force != "" {
+		return force != "0" && !strings.EqualFold(force, "false")
+	}
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	if force := os.Getenv("CLICOLOR_FORCE"); force != "" && force != "0" {
+		return true
+	}
+	if os.Getenv("CLICOLOR") == "0" || os.Getenv("TERM") == "dumb" {
+		return false
+	}
+	return isTerminal(writer)
+}
+
+// isTerminal returns true, if writer is a file that refers to the terminal
+// (character device).
+func isTerminal(writer io.Writer) bool {
+	file, ok := writer.(*os.File)
+	if !ok || file == nil {
+		return false
+	}
+	info, err := file.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
 }
@@ -2,8 +2,11 @@
 package handler
 
 import (
+	"bytes"
 	"github.com/dl1998/go-logging/internal/testutils"
+	"github.com/dl1998/go-logging/pkg/common/formatter"
 	"github.com/dl1998/go-logging/pkg/common/level"
+	"io"
 	"os"
 	"testing"
 )
@@ -136,34 +139,155 @@ func BenchmarkHandler_SetToLevel(b *testing.B) {
 	}
 }
 
-// TestConsoleSupportsANSIColors tests that consoleSupportsANSIColors returns
-// true if console supports ANSI colors, or otherwise it returns false.
+// TestHandler_SanitizeMode tests that Handler.SanitizeMode returns
+// sanitization mode set by Handler.SetSanitizeMode.
+func TestHandler_SanitizeMode(t *testing.T) {
+	newHandler := New(fromLevel, toLevel, os.Stdout)
+
+	testutils.AssertEquals(t, formatter.SanitizeDefault, newHandler.SanitizeMode())
+
+	newHandler.SetSanitizeMode(formatter.SanitizeStrip)
+
+	testutils.AssertEquals(t, formatter.SanitizeStrip, newHandler.SanitizeMode())
+}
+
+// TestConsoleSupportsANSIColors tests that consoleSupportsANSIColors detects
+// terminal and honours NO_COLOR, FORCE_COLOR, CLICOLOR and CLICOLOR_FORCE.
 func TestConsoleSupportsANSIColors(t *testing.T) {
+	// /dev/null is a character device, so it is detected as a terminal.
+	terminal, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	testutils.AssertNil(t, err)
+	defer terminal.Close()
+
+	_, pipe, err := os.Pipe()
+	testutils.AssertNil(t, err)
+	defer pipe.Close()
+
 	parameters := map[string]struct {
-		Expected bool
-		Term     string
+		Expected    bool
+		Environment map[string]string
+		Writer      io.Writer
 	}{
-		"Console supports ANSI colors": {
-			true, "xterm-256color",
-		},
-		"Console don't support ANSI colors": {
-			false, "",
-		},
+		"Terminal":                    {true, map[string]string{}, terminal},
+		"Pipe":                        {false, map[string]string{}, pipe},
+		"Buffer":                      {false, map[string]string{}, &bytes.Buffer{}},
+		"Dumb Terminal":               {false, map[string]string{"TERM": "dumb"}, terminal},
+		"NO_COLOR":                    {false, map[string]string{"NO_COLOR": "1"}, terminal},
+		"CLICOLOR=0":                  {false, map[string]string{"CLICOLOR": "0"}, terminal},
+		"CLICOLOR_FORCE":              {true, map[string]string{"CLICOLOR_FORCE": "1"}, pipe},
+		"FORCE_COLOR":                 {true, map[string]string{"FORCE_COLOR": "1"}, pipe},
+		"FORCE_COLOR=0":               {false, map[string]string{"FORCE_COLOR": "0"}, terminal},
+		"FORCE_COLOR Before NO_COLOR": {true, map[string]string{"FORCE_COLOR": "true", "NO_COLOR": "1"}, pipe},
 	}
 	for name, parameter := range parameters {
 		t.Run(name, func(t *testing.T) {
-			_ = os.Setenv("TERM", parameter.Term)
-			result := consoleSupportsANSIColors()
+			for _, variable := range []string{"TERM", "NO_COLOR", "CLICOLOR", "CLICOLOR_FORCE", "FORCE_COLOR"} {
+				t.Setenv(variable, parameter.Environment[variable])
+			}
+			result := consoleSupportsANSIColors(parameter.Writer)
 			testutils.AssertEquals(t, parameter.Expected, result)
 		})
 	}
-	_ = os.Setenv("TERM", "xterm-256color")
 }
 
 // BenchmarkConsoleSupportsANSIColors performs benchmarking of the
 // consoleSupportsANSIColors().
 func BenchmarkConsoleSupportsANSIColors(b *testing.B) {
 	for index := 0; index < b.N; index++ {
-		consoleSupportsANSIColors()
+		consoleSupportsANSIColors(os.Stdout)
+	}
+}
+
+// TestHandler_SetColorOutput tests that Handler.SetColorOutput sets color
+// output mode of the Handler.
+func TestHandler_SetColorOutput(t *testing.T) {
+	newHandler := New(fromLevel, toLevel, os.Stdout)
+
+	testutils.AssertEquals(t, ColorAuto, newHandler.ColorOutput())
+
+	newHandler.SetColorOutput(ColorNever)
+
+	testutils.AssertEquals(t, ColorNever, newHandler.ColorOutput())
+}
+
+// TestHandler_Colored tests that Handler.Colored forces colors on or off
+// according to the color output mode and uses detection for the ColorAuto.
+func TestHandler_Colored(t *testing.T) {
+	newHandler := New(fromLevel, toLevel, &bytes.Buffer{})
+	newHandler.ConsoleSupportsANSIColors = func() bool {
+		return true
+	}
+
+	testutils.AssertEquals(t, true, newHandler.Colored())
+
+	newHandler.SetColorOutput(ColorNever)
+
+	testutils.AssertEquals(t, false, newHandler.Colored())
+
+	newHandler.ConsoleSupportsANSIColors = func() bool {
+		return false
+	}
+	newHandler.SetColorOutput(ColorAlways)
+
+	testutils.AssertEquals(t, true, newHandler.Colored())
+}
+
+// TestHandler_Colored_Detection tests that Handler detects color support, when
+// writer or color output mode is set, and not on each call of Handler.Colored.
+func TestHandler_Colored_Detection(t *testing.T) {
+	for _, variable := range []string{"TERM", "NO_COLOR", "CLICOLOR", "CLICOLOR_FORCE", "FORCE_COLOR"} {
+		t.Setenv(variable, "")
+	}
+
+	newHandler := New(fromLevel, toLevel, &bytes.Buffer{})
+
+	testutils.AssertEquals(t, false, newHandler.Colored())
+
+	t.Setenv("FORCE_COLOR", "1")
+
+	testutils.AssertEquals(t, false, newHandler.Colored())
+
+	newHandler.SetColorOutput(ColorAuto)
+
+	testutils.AssertEquals(t, true, newHandler.Colored())
+
+	t.Setenv("FORCE_COLOR", "0")
+	newHandler.SetWriter(&bytes.Buffer{})
+
+	testutils.AssertEquals(t, false, newHandler.Colored())
+}
+
+// BenchmarkHandler_Colored performs benchmarking of the Handler.Colored().
+func BenchmarkHandler_Colored(b *testing.B) {
+	newHandler := New(fromLevel, toLevel, os.Stdout)
+
+	for index := 0; index < b.N; index++ {
+		newHandler.Colored()
+	}
+}
+
+// TestParseColorOutput tests that ParseColorOutput returns color output mode by
+// its name and error for the unknown name.
+func TestParseColorOutput(t *testing.T) {
+	tests := map[string]ColorOutput{
+		"":       ColorAuto,
+		"auto":   ColorAuto,
+		"Always": ColorAlways,
+		"NEVER":  ColorNever,
+	}
+
+	for name, expected := range tests {
+		t.Run(name, func(t *testing.T) {
+			output, err := ParseColorOutput(name)
+
+			testutils.AssertNil(t, err)
+			testutils.AssertEquals(t, expected, output)
+		})
 	}
+
+	_, err := ParseColorOutput("unknown")
+
+	testutils.AssertNotNil(t, err)
+	testutils.AssertEquals(t, "always", ColorAlways.String())
+	testutils.AssertEquals(t, "unknown", ColorOutput(-1).String())
 }
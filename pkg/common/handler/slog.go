@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"github.com/dl1998/go-logging/pkg/common/logrecord"
+	"log/slog"
+	"slices"
+)
+
+// NewSlogRecord converts log record into slog.Record with the provided message
+// and attributes. Time, level and place in the code are taken from the log
+// record, attributes are sorted by the key and nested maps are converted into
+// groups.
+func NewSlogRecord(record logrecord.Interface, message string, attributes map[string]interface{}) slog.Record {
+	slogRecord := slog.NewRecord(record.RawTime(), record.Level().Slog(), message, record.ProgramCounter())
+	slogRecord.AddAttrs(SlogAttributes(attributes)...)
+	return slogRecord
+}
+
+// SlogAttributes converts map of the values into slog attributes sorted by the
+// key, nested maps are converted into groups.
+func SlogAttributes(values map[string]interface{}) []slog.Attr {
+	attributes := make([]slog.Attr, 0, len(values))
+	for key, value := range values {
+		if nested, ok := value.(map[string]interface{}); ok {
+			attributes = append(attributes, slog.Attr{Key: key, Value: slog.GroupValue(SlogAttributes(nested)...)})
+		} else {
+			attributes = append(attributes, slog.Any(key, value))
+		}
+	}
+	slices.SortFunc(attributes, func(first slog.Attr, second slog.Attr) int {
+		if first.Key < second.Key {
+			return -1
+		} else if first.Key > second.Key {
+			return 1
+		}
+		return 0
+	})
+	return attributes
+}
+
+// HandleSlog passes slog.Record to the slog.Handler, if it is enabled for the
+// level of the record. Errors returned by the slog.Handler are printed.
+func HandleSlog(slogHandler slog.Handler, slogRecord slog.Record) {
+	ctx := context.Background()
+	if !slogHandler.Enabled(ctx, slogRecord.Level) {
+		return
+	}
+	if err := slogHandler.Handle(ctx, slogRecord); err != nil {
+		fmt.Println(err)
+	}
+}
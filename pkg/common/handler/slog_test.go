@@ -0,0 +1,101 @@
+// Package handler_test has tests for handler package.
+package handler
+
+import (
+	"bytes"
+	"github.com/dl1998/go-logging/internal/testutils"
+	"github.com/dl1998/go-logging/pkg/common/level"
+	"github.com/dl1998/go-logging/pkg/common/logrecord"
+	"io"
+	"log/slog"
+	"runtime"
+	"strconv"
+	"testing"
+)
+
+var slogValues = map[string]interface{}{
+	"rows":    3,
+	"request": map[string]interface{}{"id": "abc"},
+	"error":   "timeout",
+}
+
+// TestNewSlogRecord tests that NewSlogRecord converts log record into
+// slog.Record with the same time, level, place in the code and attributes.
+func TestNewSlogRecord(t *testing.T) {
+	record := logrecord.New("test", level.Warning, "", 1)
+
+	slogRecord := NewSlogRecord(record, "message", slogValues)
+
+	testutils.AssertEquals(t, record.RawTime(), slogRecord.Time)
+	testutils.AssertEquals(t, slog.LevelWarn, slogRecord.Level)
+	testutils.AssertEquals(t, "message", slogRecord.Message)
+	testutils.AssertEquals(t, record.ProgramCounter(), slogRecord.PC)
+	testutils.AssertEquals(t, 3, slogRecord.NumAttrs())
+}
+
+// BenchmarkNewSlogRecord performs benchmarking of the NewSlogRecord().
+func BenchmarkNewSlogRecord(b *testing.B) {
+	record := logrecord.New("test", level.Warning, "", 1)
+
+	for index := 0; index < b.N; index++ {
+		NewSlogRecord(record, "message", slogValues)
+	}
+}
+
+// TestSlogAttributes tests that SlogAttributes converts values into sorted
+// attributes and nested maps into groups.
+func TestSlogAttributes(t *testing.T) {
+	attributes := SlogAttributes(slogValues)
+
+	testutils.AssertEquals(t, 3, len(attributes))
+	testutils.AssertEquals(t, true, attributes[0].Equal(slog.String("error", "timeout")))
+	testutils.AssertEquals(t, true, attributes[1].Equal(slog.Group("request", "id", "abc")))
+	testutils.AssertEquals(t, true, attributes[2].Equal(slog.Int("rows", 3)))
+}
+
+// BenchmarkSlogAttributes performs benchmarking of the SlogAttributes().
+func BenchmarkSlogAttributes(b *testing.B) {
+	for index := 0; index < b.N; index++ {
+		SlogAttributes(slogValues)
+	}
+}
+
+// TestHandleSlog tests that HandleSlog passes slog.Record to the slog.Handler
+// only if it is enabled for the level of the record.
+func TestHandleSlog(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	slogHandler := slog.NewTextHandler(buffer, &slog.HandlerOptions{
+		AddSource: true,
+		Level:     slog.LevelInfo,
+		ReplaceAttr: func(groups []string, attribute slog.Attr) slog.Attr {
+			if attribute.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			if source, ok := attribute.Value.Any().(*slog.Source); ok {
+				return slog.Int("line", source.Line)
+			}
+			return attribute
+		},
+	})
+
+	_, _, line, _ := runtime.Caller(0)
+	HandleSlog(slogHandler, NewSlogRecord(logrecord.New("test", level.Warning, "", 1), "message", slogValues))
+
+	expected := "level=WARN line=" + strconv.Itoa(line+1) + " msg=message error=timeout request.id=abc rows=3\n"
+	testutils.AssertEquals(t, expected, buffer.String())
+
+	buffer.Reset()
+	HandleSlog(slogHandler, NewSlogRecord(logrecord.New("test", level.Debug, "", 1), "message", slogValues))
+
+	testutils.AssertEquals(t, "", buffer.String())
+}
+
+// BenchmarkHandleSlog performs benchmarking of the HandleSlog().
+func BenchmarkHandleSlog(b *testing.B) {
+	slogHandler := slog.NewJSONHandler(io.Discard, nil)
+	slogRecord := NewSlogRecord(logrecord.New("test", level.Warning, "", 1), "message", slogValues)
+
+	for index := 0; index < b.N; index++ {
+		HandleSlog(slogHandler, slogRecord)
+	}
+}
@@ -0,0 +1,52 @@
+// Package lazy contains lazy parameters of the log records, they are evaluated
+// only if log record is accepted by at least one handler.
+package lazy
+
+// Value is a parameter of the log record that is computed only if the log
+// record is written, e.g. Value(func() any { return dump(state) }). It is
+// evaluated once per log record in the goroutine of the caller.
+type Value func() any
+
+// Resolve returns result of the Value, other values are returned as is. Nil
+// Value is resolved to nil.
+func Resolve(value any) any {
+	lazyValue, ok := value.(Value)
+	if !ok {
+		return value
+	}
+	if lazyValue == nil {
+		return nil
+	}
+	return lazyValue()
+}
+
+// Slice returns parameters with evaluated lazy values. Parameters are copied
+// only if they contain lazy values, so the provided slice is never modified.
+func Slice(parameters []any) []any {
+	for index, value := range parameters {
+		if _, ok := value.(Value); ok {
+			resolved := make([]any, len(parameters))
+			copy(resolved, parameters[:index])
+			for position := index; position < len(parameters); position++ {
+				resolved[position] = Resolve(parameters[position])
+			}
+			return resolved
+		}
+	}
+	return parameters
+}
+
+// Map returns parameters with evaluated lazy values. Parameters are copied
+// only if they contain lazy values, so the provided map is never modified.
+func Map(parameters map[string]interface{}) map[string]interface{} {
+	for _, value := range parameters {
+		if _, ok := value.(Value); ok {
+			resolved := make(map[string]interface{}, len(parameters))
+			for key, parameter := range parameters {
+				resolved[key] = Resolve(parameter)
+			}
+			return resolved
+		}
+	}
+	return parameters
+}
@@ -0,0 +1,79 @@
+// Package lazy contains tests for the lazy parameters.
+package lazy
+
+import (
+	"github.com/dl1998/go-logging/internal/testutils"
+	"testing"
+)
+
+// TestResolve tests that Resolve evaluates Value and returns other values as
+// is.
+func TestResolve(t *testing.T) {
+	testutils.AssertEquals(t, any("computed"), Resolve(Value(func() any { return "computed" })))
+	testutils.AssertEquals(t, any("plain"), Resolve("plain"))
+	testutils.AssertNil(t, Resolve(Value(nil)))
+}
+
+// TestSlice tests that Slice evaluates lazy values without modification of
+// the provided parameters.
+func TestSlice(t *testing.T) {
+	parameters := []any{"first", Value(func() any { return 2 }), 3}
+
+	resolved := Slice(parameters)
+
+	testutils.AssertEquals(t, []any{"first", 2, 3}, resolved)
+
+	_, ok := parameters[1].(Value)
+
+	testutils.AssertEquals(t, true, ok)
+}
+
+// TestSlice_WithoutLazy tests that Slice returns parameters without lazy values
+// as is.
+func TestSlice_WithoutLazy(t *testing.T) {
+	parameters := []any{"first", 2}
+
+	testutils.AssertEquals(t, &parameters[0], &Slice(parameters)[0])
+}
+
+// BenchmarkSlice performs benchmarking of the Slice().
+func BenchmarkSlice(b *testing.B) {
+	parameters := []any{"first", Value(func() any { return 2 }), 3}
+
+	for index := 0; index < b.N; index++ {
+		Slice(parameters)
+	}
+}
+
+// TestMap tests that Map evaluates lazy values without modification of the
+// provided parameters.
+func TestMap(t *testing.T) {
+	parameters := map[string]interface{}{"message": "test", "state": Value(func() any { return "dumped" })}
+
+	resolved := Map(parameters)
+
+	testutils.AssertEquals(t, map[string]interface{}{"message": "test", "state": "dumped"}, resolved)
+
+	_, ok := parameters["state"].(Value)
+
+	testutils.AssertEquals(t, true, ok)
+}
+
+// TestMap_WithoutLazy tests that Map returns parameters without lazy values as
+// is.
+func TestMap_WithoutLazy(t *testing.T) {
+	parameters := map[string]interface{}{"message": "test"}
+
+	Map(parameters)["key"] = "value"
+
+	testutils.AssertEquals(t, "value", parameters["key"])
+}
+
+// BenchmarkMap performs benchmarking of the Map().
+func BenchmarkMap(b *testing.B) {
+	parameters := map[string]interface{}{"message": "test", "state": Value(func() any { return "dumped" })}
+
+	for index := 0; index < b.N; index++ {
+		Map(parameters)
+	}
+}
@@ -3,6 +3,8 @@ package level
 
 import (
 	"github.com/dl1998/go-logging/internal/testutils"
+	"log/slog"
+	"math"
 	"testing"
 )
 
@@ -184,3 +186,58 @@ func BenchmarkLogLevel_Previous(b *testing.B) {
 		level.Previous()
 	}
 }
+
+// TestFromSlog tests that FromSlog converts slog.Level to the Level.
+func TestFromSlog(t *testing.T) {
+	tests := map[slog.Level]Level{
+		slog.Level(math.MinInt): Trace,
+		slog.LevelDebug - 2:     Trace,
+		slog.LevelDebug:         Debug,
+		slog.LevelDebug + 1:     Debug,
+		slog.LevelInfo - 2:      Verbose,
+		slog.LevelInfo:          Info,
+		slog.LevelInfo + 2:      Notice,
+		slog.LevelWarn:          Warning,
+		slog.LevelWarn + 2:      Severe,
+		slog.LevelError:         Error,
+		slog.LevelError + 2:     Alert,
+		slog.LevelError + 4:     Critical,
+		slog.LevelError + 6:     Emergency,
+		slog.Level(math.MaxInt): Emergency,
+	}
+
+	for slogLevel, expected := range tests {
+		t.Run(slogLevel.String(), func(t *testing.T) {
+			testutils.AssertEquals(t, expected, FromSlog(slogLevel))
+		})
+	}
+}
+
+// BenchmarkFromSlog perform benchmarking of the FromSlog().
+func BenchmarkFromSlog(b *testing.B) {
+	for index := 0; index < b.N; index++ {
+		FromSlog(slog.LevelWarn)
+	}
+}
+
+// TestLogLevel_Slog tests that Level.Slog converts the Level to slog.Level and
+// FromSlog converts it back.
+func TestLogLevel_Slog(t *testing.T) {
+	testutils.AssertEquals(t, slog.LevelDebug, Debug.Slog())
+	testutils.AssertEquals(t, slog.LevelInfo, Info.Slog())
+	testutils.AssertEquals(t, slog.LevelWarn, Warning.Slog())
+	testutils.AssertEquals(t, slog.LevelError, Error.Slog())
+	testutils.AssertEquals(t, slog.Level(math.MinInt), All.Slog())
+	testutils.AssertEquals(t, slog.Level(math.MaxInt), Null.Slog())
+
+	for level := Trace; level <= Emergency; level = level.Next() {
+		testutils.AssertEquals(t, level, FromSlog(level.Slog()))
+	}
+}
+
+// BenchmarkLogLevel_Slog perform benchmarking of the Level.Slog().
+func BenchmarkLogLevel_Slog(b *testing.B) {
+	for index := 0; index < b.N; index++ {
+		Warning.Slog()
+	}
+}
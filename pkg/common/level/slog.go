@@ -0,0 +1,34 @@
+package level
+
+import (
+	"log/slog"
+	"math"
+)
+
+// slogStep is a distance between neighbouring levels in slog.Level units, so
+// Debug, Info, Warning and Error match slog.LevelDebug, slog.LevelInfo,
+// slog.LevelWarn and slog.LevelError.
+const slogStep = 2
+
+// FromSlog converts slog.Level to the Level. Levels between the slog levels
+// are mapped to the levels in between, e.g. slog.LevelInfo+2 is Notice and
+// slog.LevelWarn+2 is Severe. Levels below slog.LevelDebug-1 are mapped to
+// Trace, levels above slog.LevelError+7 are mapped to Emergency.
+func FromSlog(slogLevel slog.Level) Level {
+	offset := int(slogLevel-slog.LevelInfo) >> 1
+	index := min(max(int(Info-Trace)/step+offset, 0), int(Emergency-Trace)/step)
+	return Trace + Level(index*step)
+}
+
+// Slog converts the Level to slog.Level, e.g. Info is slog.LevelInfo and
+// Notice is slog.LevelInfo+2. All and Null are converted to the lowest and the
+// highest slog.Level.
+func (level Level) Slog() slog.Level {
+	switch {
+	case level <= All:
+		return slog.Level(math.MinInt)
+	case level >= Null:
+		return slog.Level(math.MaxInt)
+	}
+	return slog.LevelInfo + slog.Level((level-Info)/step*slogStep)
+}
@@ -0,0 +1,137 @@
+// Package logcontext contains registry of the context extractors, they pull
+// values (e.g. request identifier, tenant identifier, trace identifier) from the
+// context.Context into the fields of the log records.
+package logcontext
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// Extractor is a function that returns fields extracted from the context, it
+// returns nil or empty map if context does not contain its values.
+type Extractor func(ctx context.Context) map[string]interface{}
+
+// namedExtractor is an Extractor registered with the name.
+type namedExtractor struct {
+	// name is a name of the extractor.
+	name string
+	// extractor is a function that extracts fields from the context.
+	extractor Extractor
+}
+
+// extractors contains registered extractors in the order of registration, it is
+// replaced as a whole on every registration, so it could be read without
+// locking.
+var extractors atomic.Pointer[[]namedExtractor]
+
+// extractorsMutex synchronizes registration of the extractors.
+var extractorsMutex sync.Mutex
+
+func init() {
+	extractors.Store(&[]namedExtractor{})
+}
+
+// RegisterExtractor registers a new extractor with the provided name.
+// Registration of the extractor with existing name replaces the previous one.
+// Extractors are applied in the order of registration, so fields of the later
+// extractors take precedence.
+func RegisterExtractor(name string, extractor Extractor) error {
+	if name == "" {
+		return fmt.Errorf("invalid extractor name %q", name)
+	}
+	if extractor == nil {
+		return fmt.Errorf("extractor %q has no function", name)
+	}
+
+	extractorsMutex.Lock()
+	defer extractorsMutex.Unlock()
+
+	current := *extractors.Load()
+	updated := make([]namedExtractor, 0, len(current)+1)
+	replaced := false
+	for _, registered := range current {
+		if registered.name == name {
+			registered.extractor = extractor
+			replaced = true
+		}
+		updated = append(updated, registered)
+	}
+	if !replaced {
+		updated = append(updated, namedExtractor{name: name, extractor: extractor})
+	}
+	extractors.Store(&updated)
+
+	return nil
+}
+
+// RegisterValueExtractor registers a new extractor with the provided name that
+// extracts value stored in the context under the key into the field.
+func RegisterValueExtractor(name string, field string, key any) error {
+	return RegisterExtractor(name, ValueExtractor(field, key))
+}
+
+// UnregisterExtractor removes extractor with the provided name.
+func UnregisterExtractor(name string) {
+	extractorsMutex.Lock()
+	defer extractorsMutex.Unlock()
+
+	current := *extractors.Load()
+	updated := make([]namedExtractor, 0, len(current))
+	for _, registered := range current {
+		if registered.name != name {
+			updated = append(updated, registered)
+		}
+	}
+	extractors.Store(&updated)
+}
+
+// Extractors returns names of the registered extractors in the order of
+// registration.
+func Extractors() []string {
+	current := *extractors.Load()
+	names := make([]string, len(current))
+	for index, registered := range current {
+		names[index] = registered.name
+	}
+	return names
+}
+
+// ValueExtractor returns Extractor that extracts value stored in the context
+// under the key (see context.WithValue) into the field. Nil values are skipped.
+func ValueExtractor(field string, key any) Extractor {
+	return func(ctx context.Context) map[string]interface{} {
+		value := ctx.Value(key)
+		if value == nil {
+			return nil
+		}
+		return map[string]interface{}{field: value}
+	}
+}
+
+// Fields applies registered extractors to the context and returns extracted
+// fields. It returns nil if context is nil or none of the extractors returned
+// fields.
+func Fields(ctx context.Context) map[string]interface{} {
+	if ctx == nil {
+		return nil
+	}
+
+	var fields map[string]interface{}
+	for _, registered := range *extractors.Load() {
+		extracted := registered.extractor(ctx)
+		if len(extracted) == 0 {
+			continue
+		}
+		if fields == nil {
+			fields = make(map[string]interface{}, len(extracted))
+		}
+		for key, value := range extracted {
+			fields[key] = value
+		}
+	}
+
+	return fields
+}
@@ -0,0 +1,129 @@
+// Package logcontext contains tests for the context extractors.
+package logcontext
+
+import (
+	"context"
+	"github.com/dl1998/go-logging/internal/testutils"
+	"testing"
+)
+
+// contextKey is a type of the keys used in tests.
+type contextKey string
+
+var (
+	requestKey = contextKey("request_id")
+	tenantKey  = contextKey("tenant_id")
+)
+
+// TestRegisterExtractor tests that RegisterExtractor registers extractor that
+// is applied by Fields and UnregisterExtractor removes it.
+func TestRegisterExtractor(t *testing.T) {
+	err := RegisterExtractor("test-request", ValueExtractor("request_id", requestKey))
+
+	testutils.AssertNil(t, err)
+	testutils.AssertEquals(t, []string{"test-request"}, Extractors())
+
+	ctx := context.WithValue(context.Background(), requestKey, "abc")
+
+	testutils.AssertEquals(t, map[string]interface{}{"request_id": "abc"}, Fields(ctx))
+
+	UnregisterExtractor("test-request")
+
+	testutils.AssertEquals(t, []string{}, Extractors())
+	testutils.AssertNil(t, Fields(ctx))
+}
+
+// TestRegisterExtractor_Replace tests that RegisterExtractor replaces extractor
+// with the same name and keeps order of the registration.
+func TestRegisterExtractor_Replace(t *testing.T) {
+	defer UnregisterExtractor("test-first")
+	defer UnregisterExtractor("test-second")
+
+	_ = RegisterExtractor("test-first", ValueExtractor("id", requestKey))
+	_ = RegisterExtractor("test-second", ValueExtractor("id", tenantKey))
+	_ = RegisterExtractor("test-first", ValueExtractor("request_id", requestKey))
+
+	ctx := context.WithValue(context.WithValue(context.Background(), requestKey, "abc"), tenantKey, "acme")
+
+	testutils.AssertEquals(t, []string{"test-first", "test-second"}, Extractors())
+	testutils.AssertEquals(t, map[string]interface{}{"request_id": "abc", "id": "acme"}, Fields(ctx))
+}
+
+// TestRegisterExtractor_Error tests that RegisterExtractor returns error for
+// invalid name or missing function.
+func TestRegisterExtractor_Error(t *testing.T) {
+	tests := map[string]struct {
+		name      string
+		extractor Extractor
+	}{
+		"Empty name":   {name: "", extractor: ValueExtractor("id", requestKey)},
+		"Nil function": {name: "valid", extractor: nil},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			testutils.AssertNotNil(t, RegisterExtractor(test.name, test.extractor))
+		})
+	}
+}
+
+// BenchmarkRegisterExtractor performs benchmarking of the RegisterExtractor().
+func BenchmarkRegisterExtractor(b *testing.B) {
+	extractor := ValueExtractor("request_id", requestKey)
+
+	for index := 0; index < b.N; index++ {
+		_ = RegisterExtractor("test-benchmark", extractor)
+	}
+
+	UnregisterExtractor("test-benchmark")
+}
+
+// TestRegisterValueExtractor tests that RegisterValueExtractor registers
+// extractor of the context value.
+func TestRegisterValueExtractor(t *testing.T) {
+	defer UnregisterExtractor("test-tenant")
+
+	err := RegisterValueExtractor("test-tenant", "tenant_id", tenantKey)
+
+	ctx := context.WithValue(context.Background(), tenantKey, "acme")
+
+	testutils.AssertNil(t, err)
+	testutils.AssertEquals(t, map[string]interface{}{"tenant_id": "acme"}, Fields(ctx))
+}
+
+// TestValueExtractor tests that ValueExtractor returns value of the key and
+// skips missing values.
+func TestValueExtractor(t *testing.T) {
+	extractor := ValueExtractor("request_id", requestKey)
+
+	ctx := context.WithValue(context.Background(), requestKey, "abc")
+
+	testutils.AssertEquals(t, map[string]interface{}{"request_id": "abc"}, extractor(ctx))
+	testutils.AssertNil(t, extractor(context.Background()))
+}
+
+// TestFields_Empty tests that Fields returns nil for nil context and context
+// without values.
+func TestFields_Empty(t *testing.T) {
+	defer UnregisterExtractor("test-request")
+
+	_ = RegisterExtractor("test-request", ValueExtractor("request_id", requestKey))
+
+	testutils.AssertNil(t, Fields(nil))
+	testutils.AssertNil(t, Fields(context.Background()))
+}
+
+// BenchmarkFields performs benchmarking of the Fields().
+func BenchmarkFields(b *testing.B) {
+	defer UnregisterExtractor("test-request")
+
+	_ = RegisterExtractor("test-request", ValueExtractor("request_id", requestKey))
+
+	ctx := context.WithValue(context.Background(), requestKey, "abc")
+
+	b.ResetTimer()
+
+	for index := 0; index < b.N; index++ {
+		Fields(ctx)
+	}
+}
@@ -0,0 +1,37 @@
+package logrecord
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Clock tracks time elapsed since creation of the logger and since its
+// previous log record. It relies on the monotonic clock reading, so changes of
+// the wall clock do not affect elapsed time.
+type Clock struct {
+	// created is a time when clock has been created, it contains monotonic
+	// clock reading.
+	created time.Time
+	// previous is a time elapsed since creation of the clock till the previous
+	// log record, in nanoseconds.
+	previous atomic.Int64
+}
+
+// NewClock creates a new instance of the Clock.
+func NewClock() *Clock {
+	return &Clock{
+		created: time.Now(),
+	}
+}
+
+// Elapsed returns time elapsed since creation of the clock (relative) and since
+// the previous call (delta) till the provided time. Delta is never negative,
+// even if log records are created concurrently.
+func (clock *Clock) Elapsed(now time.Time) (relative time.Duration, delta time.Duration) {
+	relative = now.Sub(clock.created)
+	delta = relative - time.Duration(clock.previous.Swap(int64(relative)))
+	if delta < 0 {
+		delta = 0
+	}
+	return relative, delta
+}
@@ -0,0 +1,53 @@
+package logrecord
+
+import (
+	"github.com/dl1998/go-logging/internal/testutils"
+	"testing"
+	"time"
+)
+
+// TestNewClock tests that NewClock creates clock with current time.
+func TestNewClock(t *testing.T) {
+	before := time.Now()
+	clock := NewClock()
+
+	testutils.AssertEquals(t, false, clock.created.Before(before))
+	testutils.AssertEquals(t, int64(0), clock.previous.Load())
+}
+
+// TestClock_Elapsed tests that Clock.Elapsed returns time elapsed since
+// creation of the clock and since the previous call.
+func TestClock_Elapsed(t *testing.T) {
+	clock := NewClock()
+
+	relative, delta := clock.Elapsed(clock.created.Add(10 * time.Millisecond))
+
+	testutils.AssertEquals(t, 10*time.Millisecond, relative)
+	testutils.AssertEquals(t, 10*time.Millisecond, delta)
+
+	relative, delta = clock.Elapsed(clock.created.Add(25 * time.Millisecond))
+
+	testutils.AssertEquals(t, 25*time.Millisecond, relative)
+	testutils.AssertEquals(t, 15*time.Millisecond, delta)
+}
+
+// TestClock_Elapsed_NotNegative tests that Clock.Elapsed never returns
+// negative delta, if records are created out of order.
+func TestClock_Elapsed_NotNegative(t *testing.T) {
+	clock := NewClock()
+
+	clock.Elapsed(clock.created.Add(20 * time.Millisecond))
+	relative, delta := clock.Elapsed(clock.created.Add(10 * time.Millisecond))
+
+	testutils.AssertEquals(t, 10*time.Millisecond, relative)
+	testutils.AssertEquals(t, time.Duration(0), delta)
+}
+
+// BenchmarkClock_Elapsed benchmarks the Clock.Elapsed function.
+func BenchmarkClock_Elapsed(b *testing.B) {
+	clock := NewClock()
+
+	for index := 0; index < b.N; index++ {
+		clock.Elapsed(time.Now())
+	}
+}
@@ -11,9 +11,18 @@ type Interface interface {
 	Name() string
 	Time() string
 	Timestamp() int64
+	RawTime() time.Time
 	Level() level.Level
 	FileName() string
 	FileLine() int
+	FunctionName() string
+	ProgramCounter() uintptr
+	Stack() Stack
+	GoroutineID() uint64
+	Uptime() time.Duration
+	Relative() time.Duration
+	Delta() time.Duration
+	Fields() map[string]interface{}
 }
 
 // LogRecord struct represents a log record.
@@ -24,28 +33,157 @@ type LogRecord struct {
 	timeFormat string
 	// Time of the log record.
 	timestamp time.Time
+	// location is a location to which time of the log record is converted,
+	// nil keeps the local time.
+	location *time.Location
 	// Level of the log record.
 	level level.Level
 	// File name of the log record.
 	fileName string
 	// Line number of the log record.
 	fileLine int
+	// Return program counter of the place from which logger has been called.
+	programCounter uintptr
+	// captureCaller defines whether place in the code from which logger has
+	// been called shall be captured.
+	captureCaller bool
+	// captureStack defines whether stack of the goroutine shall be captured.
+	captureStack bool
+	// captureGoroutine defines whether identifier of the goroutine shall be
+	// captured.
+	captureGoroutine bool
+	// stack is a stack of the goroutine from the place in which logger has been
+	// called.
+	stack Stack
+	// goroutineID is an identifier of the goroutine from which logger has been
+	// called.
+	goroutineID uint64
+	// uptime is a time elapsed since the start of the process.
+	uptime time.Duration
+	// clock is a clock of the logger used to compute relative and delta time.
+	clock *Clock
+	// relative is a time elapsed since creation of the logger.
+	relative time.Duration
+	// delta is a time elapsed since the previous log record of the logger.
+	delta time.Duration
+	// fields are contextual fields bound to the logger.
+	fields map[string]interface{}
+}
+
+// Option represents option used to configure the LogRecord on creation.
+type Option func(*LogRecord)
+
+// WithLocation converts time of the LogRecord to the provided location. Nil
+// location keeps the local time.
+func WithLocation(location *time.Location) Option {
+	return func(record *LogRecord) {
+		record.location = location
+	}
+}
+
+// WithTime sets time of the LogRecord (e.g. slog.Record.Time) instead of the
+// current time, uptime, relative and delta time are computed for it. Zero time
+// keeps the current time.
+func WithTime(timestamp time.Time) Option {
+	return func(record *LogRecord) {
+		if !timestamp.IsZero() {
+			record.timestamp = timestamp
+		}
+	}
+}
+
+// WithCaller enables or disables lookup of the place in the code from which
+// logger has been called. File name, line and function name of the LogRecord
+// are empty, if lookup is disabled.
+func WithCaller(capture bool) Option {
+	return func(record *LogRecord) {
+		record.captureCaller = capture
+	}
+}
+
+// WithProgramCounter sets place in the code from which logger has been called
+// using program counter (e.g. slog.Record.PC) instead of the lookup by the
+// number of skipped callers. Zero program counter leaves caller empty.
+func WithProgramCounter(programCounter uintptr) Option {
+	return func(record *LogRecord) {
+		record.captureCaller = false
+		if programCounter != 0 {
+			record.setCaller(programCounter)
+		}
+	}
+}
+
+// WithStack enables or disables capturing of the goroutine stack, frames below
+// the place in the code from which logger has been called are captured.
+func WithStack(capture bool) Option {
+	return func(record *LogRecord) {
+		record.captureStack = capture
+	}
+}
+
+// WithGoroutineID enables or disables capturing of the identifier of the
+// goroutine from which logger has been called. It is disabled by default,
+// because lookup of the identifier is expensive.
+func WithGoroutineID(capture bool) Option {
+	return func(record *LogRecord) {
+		record.captureGoroutine = capture
+	}
+}
+
+// WithClock sets clock of the logger used to compute time elapsed since
+// creation of the logger and since its previous log record. Relative and delta
+// time are zero, if clock is nil.
+func WithClock(clock *Clock) Option {
+	return func(record *LogRecord) {
+		record.clock = clock
+	}
+}
+
+// WithFields attaches contextual fields bound to the logger to the LogRecord.
+// Fields are not copied, so they shall not be modified after creation of the
+// LogRecord.
+func WithFields(fields map[string]interface{}) Option {
+	return func(record *LogRecord) {
+		record.fields = fields
+	}
 }
 
 // New creates a new instance of the LogRecord.
-func New(name string, level level.Level, timeFormat string, skipCaller int) *LogRecord {
-	_, fileName, fileLine, _ := runtime.Caller(skipCaller)
+func New(name string, level level.Level, timeFormat string, skipCaller int, options ...Option) *LogRecord {
 	if timeFormat == "" {
 		timeFormat = time.RFC3339
 	}
-	return &LogRecord{
-		name:       name,
-		timeFormat: timeFormat,
-		timestamp:  time.Now(),
-		level:      level,
-		fileName:   fileName,
-		fileLine:   fileLine,
+	record := &LogRecord{
+		name:          name,
+		timeFormat:    timeFormat,
+		timestamp:     time.Now(),
+		level:         level,
+		captureCaller: true,
+	}
+	for _, option := range options {
+		option(record)
 	}
+	now := record.timestamp
+	record.uptime = now.Sub(processStart)
+	if record.location != nil {
+		record.timestamp = record.timestamp.In(record.location)
+	}
+	if record.clock != nil {
+		record.relative, record.delta = record.clock.Elapsed(now)
+	}
+	if record.captureCaller {
+		var programCounters [1]uintptr
+		if runtime.Callers(skipCaller+1, programCounters[:]) > 0 {
+			record.setCaller(programCounters[0])
+		}
+	}
+	if record.captureGoroutine {
+		record.goroutineID = currentGoroutineID()
+	}
+	if record.captureStack {
+		record.stack = captureStack(skipCaller + 1)
+	}
+	return record
 }
 
 // Name returns the name of the log record.
@@ -63,6 +201,11 @@ func (record *LogRecord) Timestamp() int64 {
 	return record.timestamp.Unix()
 }
 
+// RawTime returns the time of the log record as time.Time.
+func (record *LogRecord) RawTime() time.Time {
+	return record.timestamp
+}
+
 // Level returns the level of the log record.
 func (record *LogRecord) Level() level.Level {
 	return record.level
@@ -77,3 +220,63 @@ func (record *LogRecord) FileName() string {
 func (record *LogRecord) FileLine() int {
 	return record.fileLine
 }
+
+// FunctionName returns the fully qualified name of the function from which
+// logger has been called, e.g. "github.com/user/module/package.Function". It
+// returns empty string if function is unknown.
+func (record *LogRecord) FunctionName() string {
+	if record.programCounter == 0 {
+		return ""
+	}
+	frame, _ := runtime.CallersFrames([]uintptr{record.programCounter}).Next()
+	return frame.Function
+}
+
+// setCaller sets place in the code from which logger has been called using
+// return program counter as returned by runtime.Callers.
+func (record *LogRecord) setCaller(programCounter uintptr) {
+	frame, _ := runtime.CallersFrames([]uintptr{programCounter}).Next()
+	record.programCounter, record.fileName, record.fileLine = programCounter, frame.File, frame.Line
+}
+
+// ProgramCounter returns return program counter of the place in the code from
+// which logger has been called, as returned by runtime.Callers (compatible with
+// slog.Record.PC). It returns 0 if caller has not been captured.
+func (record *LogRecord) ProgramCounter() uintptr {
+	return record.programCounter
+}
+
+// Stack returns the stack of the goroutine captured from the place in which
+// logger has been called. It returns nil if stack has not been captured.
+func (record *LogRecord) Stack() Stack {
+	return record.stack
+}
+
+// GoroutineID returns identifier of the goroutine from which logger has been
+// called. It returns 0 if identifier has not been captured.
+func (record *LogRecord) GoroutineID() uint64 {
+	return record.goroutineID
+}
+
+// Uptime returns time elapsed since the start of the process till creation of
+// the log record.
+func (record *LogRecord) Uptime() time.Duration {
+	return record.uptime
+}
+
+// Relative returns time elapsed since creation of the logger till creation of
+// the log record.
+func (record *LogRecord) Relative() time.Duration {
+	return record.relative
+}
+
+// Delta returns time elapsed since the previous log record of the same logger.
+func (record *LogRecord) Delta() time.Duration {
+	return record.delta
+}
+
+// Fields returns contextual fields bound to the logger, it returns nil if
+// logger does not have bound fields.
+func (record *LogRecord) Fields() map[string]interface{} {
+	return record.fields
+}
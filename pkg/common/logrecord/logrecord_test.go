@@ -36,6 +36,184 @@ func BenchmarkNew(b *testing.B) {
 	}
 }
 
+// TestNew_WithLocation tests that New converts time of the LogRecord to the
+// location provided by WithLocation option.
+func TestNew_WithLocation(t *testing.T) {
+	tests := map[string]struct {
+		location *time.Location
+		expected *time.Location
+	}{
+		"UTC":   {location: time.UTC, expected: time.UTC},
+		"Local": {location: nil, expected: time.Local},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			record := New(name, logLevel, timeFormat, skipCallers, WithLocation(test.location))
+
+			testutils.AssertEquals(t, test.expected, record.timestamp.Location())
+		})
+	}
+}
+
+// BenchmarkNew_WithLocation benchmarks the New function with WithLocation
+// option.
+func BenchmarkNew_WithLocation(b *testing.B) {
+	for index := 0; index < b.N; index++ {
+		New(name, logLevel, "", skipCallers, WithLocation(time.UTC))
+	}
+}
+
+// TestNew_WithTime tests that New uses time provided by WithTime option and
+// converts it to the location, zero time keeps the current time.
+func TestNew_WithTime(t *testing.T) {
+	timestamp := time.Date(2024, time.March, 1, 12, 30, 0, 0, time.FixedZone("CET", 3600))
+
+	record := New(name, logLevel, timeFormat, skipCallers, WithTime(timestamp), WithLocation(time.UTC))
+
+	testutils.AssertEquals(t, timestamp.UTC(), record.RawTime())
+
+	before := time.Now()
+	record = New(name, logLevel, timeFormat, skipCallers, WithTime(time.Time{}))
+
+	testutils.AssertEquals(t, false, record.RawTime().Before(before))
+}
+
+// BenchmarkNew_WithTime benchmarks the New function with WithTime option.
+func BenchmarkNew_WithTime(b *testing.B) {
+	timestamp := time.Now()
+
+	for index := 0; index < b.N; index++ {
+		New(name, logLevel, "", skipCallers, WithTime(timestamp))
+	}
+}
+
+// TestNew_WithCaller tests that New skips lookup of the caller, if it is
+// disabled by WithCaller option.
+func TestNew_WithCaller(t *testing.T) {
+	record := New(name, logLevel, timeFormat, skipCallers, WithCaller(false))
+
+	testutils.AssertEquals(t, "", record.fileName)
+	testutils.AssertEquals(t, 0, record.fileLine)
+	testutils.AssertEquals(t, "", record.FunctionName())
+}
+
+// BenchmarkNew_WithCaller benchmarks the New function with and without lookup
+// of the caller.
+func BenchmarkNew_WithCaller(b *testing.B) {
+	benchmarks := map[string]bool{
+		"With Caller":    true,
+		"Without Caller": false,
+	}
+
+	for benchmarkName, capture := range benchmarks {
+		b.Run(benchmarkName, func(b *testing.B) {
+			for index := 0; index < b.N; index++ {
+				New(name, logLevel, "", skipCallers, WithCaller(capture))
+			}
+		})
+	}
+}
+
+// TestNew_WithProgramCounter tests that New resolves caller from the program
+// counter provided by WithProgramCounter option.
+func TestNew_WithProgramCounter(t *testing.T) {
+	programCounters := make([]uintptr, 1)
+	runtime.Callers(1, programCounters)
+	_, file, line, _ := runtime.Caller(0)
+
+	record := New(name, logLevel, timeFormat, skipCallers, WithProgramCounter(programCounters[0]))
+
+	testutils.AssertEquals(t, file, record.FileName())
+	testutils.AssertEquals(t, line-1, record.FileLine())
+	testutils.AssertEquals(t, "github.com/dl1998/go-logging/pkg/common/logrecord.TestNew_WithProgramCounter", record.FunctionName())
+
+	empty := New(name, logLevel, timeFormat, skipCallers, WithProgramCounter(0))
+
+	testutils.AssertEquals(t, "", empty.FileName())
+	testutils.AssertEquals(t, 0, empty.FileLine())
+}
+
+// BenchmarkNew_WithProgramCounter benchmarks the New function with caller
+// resolved from the program counter.
+func BenchmarkNew_WithProgramCounter(b *testing.B) {
+	programCounters := make([]uintptr, 1)
+	runtime.Callers(1, programCounters)
+
+	for index := 0; index < b.N; index++ {
+		New(name, logLevel, timeFormat, skipCallers, WithProgramCounter(programCounters[0]))
+	}
+}
+
+// TestNew_WithStack tests that New captures stack starting from the caller, if
+// it is enabled by WithStack option.
+func TestNew_WithStack(t *testing.T) {
+	record := New(name, logLevel, timeFormat, skipCallers, WithStack(true))
+
+	testutils.AssertEquals(t, "github.com/dl1998/go-logging/pkg/common/logrecord.TestNew_WithStack", record.Stack()[0].Function)
+	testutils.AssertEquals(t, record.FileLine(), record.Stack()[0].Line)
+}
+
+// TestNew_WithoutStack tests that New does not capture stack by default.
+func TestNew_WithoutStack(t *testing.T) {
+	record := New(name, logLevel, timeFormat, skipCallers)
+
+	testutils.AssertNil(t, record.Stack())
+}
+
+// TestNew_WithGoroutineID tests that New captures identifier of the goroutine,
+// if it is enabled by WithGoroutineID option.
+func TestNew_WithGoroutineID(t *testing.T) {
+	record := New(name, logLevel, timeFormat, skipCallers, WithGoroutineID(true))
+
+	testutils.AssertEquals(t, currentGoroutineID(), record.GoroutineID())
+}
+
+// TestNew_WithoutGoroutineID tests that New does not capture identifier of the
+// goroutine by default.
+func TestNew_WithoutGoroutineID(t *testing.T) {
+	record := New(name, logLevel, timeFormat, skipCallers)
+
+	testutils.AssertEquals(t, uint64(0), record.GoroutineID())
+}
+
+// TestUptime tests that Uptime returns duration since start of the process.
+func TestUptime(t *testing.T) {
+	record := New(name, logLevel, timeFormat, skipCallers)
+
+	testutils.AssertEquals(t, record.RawTime().Sub(ProcessStart()), record.Uptime())
+}
+
+// BenchmarkUptime benchmarks the Uptime function.
+func BenchmarkUptime(b *testing.B) {
+	record := New(name, logLevel, timeFormat, skipCallers)
+	for index := 0; index < b.N; index++ {
+		record.Uptime()
+	}
+}
+
+// TestNew_WithClock tests that New computes relative and delta time using
+// clock of the logger.
+func TestNew_WithClock(t *testing.T) {
+	clock := NewClock()
+
+	first := New(name, logLevel, timeFormat, skipCallers, WithClock(clock))
+	second := New(name, logLevel, timeFormat, skipCallers, WithClock(clock))
+
+	testutils.AssertEquals(t, first.RawTime().Sub(clock.created), first.Relative())
+	testutils.AssertEquals(t, first.Relative(), first.Delta())
+	testutils.AssertEquals(t, second.Relative()-first.Relative(), second.Delta())
+}
+
+// TestNew_WithoutClock tests that relative and delta time are zero, if clock
+// is not provided.
+func TestNew_WithoutClock(t *testing.T) {
+	record := New(name, logLevel, timeFormat, skipCallers, WithClock(nil))
+
+	testutils.AssertEquals(t, time.Duration(0), record.Relative())
+	testutils.AssertEquals(t, time.Duration(0), record.Delta())
+}
+
 // TestName tests that Name function returns the name of the log record.
 func TestName(t *testing.T) {
 	record := New(name, logLevel, "", skipCallers)
@@ -81,6 +259,21 @@ func BenchmarkTimestamp(b *testing.B) {
 	}
 }
 
+// TestRawTime tests that RawTime function returns the time of the log record.
+func TestRawTime(t *testing.T) {
+	record := New(name, logLevel, "", skipCallers)
+
+	testutils.AssertEquals(t, record.timestamp, record.RawTime())
+}
+
+// BenchmarkRawTime benchmarks the RawTime function.
+func BenchmarkRawTime(b *testing.B) {
+	record := New(name, logLevel, "", skipCallers)
+	for index := 0; index < b.N; index++ {
+		record.RawTime()
+	}
+}
+
 // TestLevel tests that Level function returns the level of the log record.
 func TestLevel(t *testing.T) {
 	record := New(name, logLevel, "", skipCallers)
@@ -127,3 +320,71 @@ func BenchmarkFileLine(b *testing.B) {
 		record.FileLine()
 	}
 }
+
+// TestFunctionName tests that FunctionName function returns the name of the
+// function from which log record has been created.
+func TestFunctionName(t *testing.T) {
+	record := New(name, logLevel, "", skipCallers)
+
+	testutils.AssertEquals(t, "github.com/dl1998/go-logging/pkg/common/logrecord.TestFunctionName", record.FunctionName())
+}
+
+// TestFunctionName_Unknown tests that FunctionName function returns empty
+// string if function is unknown.
+func TestFunctionName_Unknown(t *testing.T) {
+	record := &LogRecord{}
+
+	testutils.AssertEquals(t, "", record.FunctionName())
+}
+
+// BenchmarkFunctionName benchmarks the FunctionName function.
+func BenchmarkFunctionName(b *testing.B) {
+	record := New(name, logLevel, "", skipCallers)
+	for index := 0; index < b.N; index++ {
+		record.FunctionName()
+	}
+}
+
+// TestProgramCounter tests that ProgramCounter function returns program
+// counter of the caller, or 0 if caller has not been captured.
+func TestProgramCounter(t *testing.T) {
+	programCounter, _, _, _ := runtime.Caller(0)
+	record := New(name, logLevel, "", skipCallers)
+
+	testutils.AssertEquals(t, runtime.FuncForPC(programCounter).Name(), runtime.FuncForPC(record.ProgramCounter()).Name())
+	testutils.AssertEquals(t, uintptr(0), New(name, logLevel, "", skipCallers, WithCaller(false)).ProgramCounter())
+}
+
+// BenchmarkProgramCounter benchmarks the ProgramCounter function.
+func BenchmarkProgramCounter(b *testing.B) {
+	record := New(name, logLevel, "", skipCallers)
+	for index := 0; index < b.N; index++ {
+		record.ProgramCounter()
+	}
+}
+
+// TestFields tests that Fields function returns fields provided by WithFields
+// option.
+func TestFields(t *testing.T) {
+	fields := map[string]interface{}{"request_id": "abc"}
+
+	record := New(name, logLevel, "", skipCallers, WithFields(fields))
+
+	testutils.AssertEquals(t, fields, record.Fields())
+}
+
+// TestFields_Empty tests that Fields function returns nil, if fields have not
+// been provided.
+func TestFields_Empty(t *testing.T) {
+	record := New(name, logLevel, "", skipCallers)
+
+	testutils.AssertNil(t, record.Fields())
+}
+
+// BenchmarkFields benchmarks the Fields function.
+func BenchmarkFields(b *testing.B) {
+	record := New(name, logLevel, "", skipCallers, WithFields(map[string]interface{}{"request_id": "abc"}))
+	for index := 0; index < b.N; index++ {
+		record.Fields()
+	}
+}
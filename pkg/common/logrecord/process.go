@@ -0,0 +1,37 @@
+package logrecord
+
+import (
+	"bytes"
+	"runtime"
+	"strconv"
+	"time"
+)
+
+// processStart is a time when the process has been started (package has been
+// initialized), it contains monotonic clock reading.
+var processStart = time.Now()
+
+// goroutinePrefix is a prefix of the first line of the goroutine stack.
+var goroutinePrefix = []byte("goroutine ")
+
+// ProcessStart returns time when the process has been started.
+func ProcessStart() time.Time {
+	return processStart
+}
+
+// currentGoroutineID returns identifier of the current goroutine, it is parsed
+// from the header of the goroutine stack ("goroutine 1 [running]:"). It returns
+// 0 if identifier could not be parsed.
+func currentGoroutineID() uint64 {
+	var buffer [64]byte
+	header := buffer[:runtime.Stack(buffer[:], false)]
+	header = bytes.TrimPrefix(header, goroutinePrefix)
+	if end := bytes.IndexByte(header, ' '); end > 0 {
+		header = header[:end]
+	}
+	id, err := strconv.ParseUint(string(header), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return id
+}
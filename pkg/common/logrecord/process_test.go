@@ -0,0 +1,33 @@
+package logrecord
+
+import (
+	"github.com/dl1998/go-logging/internal/testutils"
+	"testing"
+	"time"
+)
+
+// TestProcessStart tests that ProcessStart returns time in the past.
+func TestProcessStart(t *testing.T) {
+	testutils.AssertEquals(t, true, ProcessStart().Before(time.Now()))
+}
+
+// TestCurrentGoroutineID tests that currentGoroutineID returns distinct
+// identifiers for different goroutines.
+func TestCurrentGoroutineID(t *testing.T) {
+	current := currentGoroutineID()
+
+	other := make(chan uint64)
+	go func() {
+		other <- currentGoroutineID()
+	}()
+
+	testutils.AssertEquals(t, true, current > 0)
+	testutils.AssertEquals(t, false, current == <-other)
+}
+
+// BenchmarkCurrentGoroutineID benchmarks the currentGoroutineID function.
+func BenchmarkCurrentGoroutineID(b *testing.B) {
+	for index := 0; index < b.N; index++ {
+		currentGoroutineID()
+	}
+}
@@ -0,0 +1,65 @@
+package logrecord
+
+import (
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// maxStackDepth is the maximum number of frames captured in the stack.
+const maxStackDepth = 32
+
+// Frame represents a single frame of the goroutine stack.
+type Frame struct {
+	// Function is a fully qualified name of the function.
+	Function string `json:"function"`
+	// File is a name of the file.
+	File string `json:"file"`
+	// Line is a line in the file.
+	Line int `json:"line"`
+}
+
+// Stack represents a goroutine stack, the first frame is the innermost one.
+type Stack []Frame
+
+// String returns stack formatted similar to the Go panic output, function name
+// followed by the file and line on the next line indented with tab.
+func (stack Stack) String() string {
+	var builder strings.Builder
+	for index, frame := range stack {
+		if index > 0 {
+			builder.WriteString("\n")
+		}
+		builder.WriteString(frame.Function)
+		builder.WriteString("\n\t")
+		builder.WriteString(frame.File)
+		builder.WriteString(":")
+		builder.WriteString(strconv.Itoa(frame.Line))
+	}
+	return builder.String()
+}
+
+// captureStack captures stack of the current goroutine, skip defines the number
+// of frames to skip as in runtime.Callers. Frames of the runtime package are
+// omitted.
+func captureStack(skip int) Stack {
+	programCounters := make([]uintptr, maxStackDepth)
+	count := runtime.Callers(skip+1, programCounters)
+	frames := runtime.CallersFrames(programCounters[:count])
+
+	stack := make(Stack, 0, count)
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, "runtime.") {
+			stack = append(stack, Frame{
+				Function: frame.Function,
+				File:     frame.File,
+				Line:     frame.Line,
+			})
+		}
+		if !more {
+			break
+		}
+	}
+	return stack
+}
@@ -0,0 +1,50 @@
+package logrecord
+
+import (
+	"github.com/dl1998/go-logging/internal/testutils"
+	"strings"
+	"testing"
+)
+
+// TestStack_String tests that Stack.String formats frames of the stack.
+func TestStack_String(t *testing.T) {
+	stack := Stack{
+		{Function: "main.handle", File: "/app/main.go", Line: 12},
+		{Function: "main.main", File: "/app/main.go", Line: 5},
+	}
+
+	expected := "main.handle\n\t/app/main.go:12\nmain.main\n\t/app/main.go:5"
+
+	testutils.AssertEquals(t, expected, stack.String())
+}
+
+// BenchmarkStack_String benchmarks the Stack.String function.
+func BenchmarkStack_String(b *testing.B) {
+	stack := Stack{
+		{Function: "main.handle", File: "/app/main.go", Line: 12},
+		{Function: "main.main", File: "/app/main.go", Line: 5},
+	}
+
+	for index := 0; index < b.N; index++ {
+		_ = stack.String()
+	}
+}
+
+// TestCaptureStack tests that captureStack captures stack starting from the
+// caller and omits frames of the runtime package.
+func TestCaptureStack(t *testing.T) {
+	stack := captureStack(1)
+
+	testutils.AssertEquals(t, "github.com/dl1998/go-logging/pkg/common/logrecord.TestCaptureStack", stack[0].Function)
+
+	for _, frame := range stack {
+		testutils.AssertEquals(t, false, strings.HasPrefix(frame.Function, "runtime."))
+	}
+}
+
+// BenchmarkCaptureStack benchmarks the captureStack function.
+func BenchmarkCaptureStack(b *testing.B) {
+	for index := 0; index < b.N; index++ {
+		captureStack(1)
+	}
+}
@@ -0,0 +1,249 @@
+// Package redaction contains redaction engine that masks sensitive data (e.g.
+// passwords, tokens, credit card numbers) in the log records.
+package redaction
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Action defines what shall be done with the sensitive value.
+type Action int
+
+const (
+	// Replace replaces sensitive value with the replacement ("***" by
+	// default).
+	Replace Action = iota
+	// Drop removes sensitive value. Keys matched by the key rule are removed
+	// from the parameters, matches of the value rule are removed from the text.
+	Drop
+	// Hash replaces sensitive value with the salted SHA-256 hash, so equal
+	// values could be correlated without disclosure.
+	Hash
+	// KeepLast4 replaces sensitive value with the replacement followed by the
+	// last 4 characters of the value.
+	KeepLast4
+)
+
+// DefaultReplacement is a replacement used by the Replace and KeepLast4
+// actions, if replacement is not set.
+const DefaultReplacement = "***"
+
+// actionNames maps Action values to their names.
+var actionNames = map[Action]string{
+	Replace:   "replace",
+	Drop:      "drop",
+	Hash:      "hash",
+	KeepLast4: "last4",
+}
+
+// String returns name of the Action.
+func (action Action) String() string {
+	if name, ok := actionNames[action]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// ParseAction returns Action by its name, empty name is parsed as Replace. It
+// returns error if name is unknown.
+func ParseAction(name string) (Action, error) {
+	if name == "" {
+		return Replace, nil
+	}
+	for action, actionName := range actionNames {
+		if strings.EqualFold(name, actionName) {
+			return action, nil
+		}
+	}
+	return Replace, fmt.Errorf("unknown redaction action %q", name)
+}
+
+// Predefined patterns of the sensitive values.
+var (
+	// CreditCardPattern matches credit card numbers (13-19 digits, optionally
+	// separated by spaces or dashes).
+	CreditCardPattern = regexp.MustCompile(`\b\d(?:[ -]?\d){12,18}\b`)
+	// EmailPattern matches email addresses.
+	EmailPattern = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)
+	// JWTPattern matches JSON Web Tokens.
+	JWTPattern = regexp.MustCompile(`\beyJ[A-Za-z0-9_-]*\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`)
+)
+
+// patterns maps names of the predefined patterns to their regular expressions.
+var patterns = map[string]*regexp.Regexp{
+	"credit-card": CreditCardPattern,
+	"email":       EmailPattern,
+	"jwt":         JWTPattern,
+}
+
+// Pattern returns predefined pattern by its name ("credit-card", "email" or
+// "jwt"). It returns error if name is unknown.
+func Pattern(name string) (*regexp.Regexp, error) {
+	if pattern, ok := patterns[strings.ToLower(name)]; ok {
+		return pattern, nil
+	}
+	return nil, fmt.Errorf("unknown redaction pattern %q", name)
+}
+
+// Rule describes sensitive data and action applied to it. Rule with Key
+// applies action to the whole value of the matching keys, rule with Value
+// applies action to every match of the regular expression in the string
+// values.
+type Rule struct {
+	// Key is a pattern of the key name, it matches keys that contain it
+	// (case-insensitive), e.g. "password" matches "user_password".
+	Key string
+	// Value is a regular expression of the sensitive value.
+	Value *regexp.Regexp
+	// Action is an action applied to the sensitive value.
+	Action Action
+}
+
+// matchesKey returns true, if rule matches the key.
+func (rule Rule) matchesKey(key string) bool {
+	return rule.Key != "" && strings.Contains(strings.ToLower(key), strings.ToLower(rule.Key))
+}
+
+// Redactor applies redaction rules to the messages and parameters of the log
+// records.
+type Redactor struct {
+	// rules are redaction rules.
+	rules []Rule
+	// salt is a salt used by the Hash action.
+	salt string
+	// replacement is a replacement used by the Replace and KeepLast4 actions.
+	replacement string
+}
+
+// New creates a new instance of the Redactor with the salt used by the Hash
+// action and redaction rules.
+func New(salt string, rules ...Rule) *Redactor {
+	return &Redactor{
+		rules:       rules,
+		salt:        salt,
+		replacement: DefaultReplacement,
+	}
+}
+
+// Rules returns redaction rules of the Redactor.
+func (redactor *Redactor) Rules() []Rule {
+	return redactor.rules
+}
+
+// Replacement returns replacement used by the Replace and KeepLast4 actions.
+func (redactor *Redactor) Replacement() string {
+	return redactor.replacement
+}
+
+// SetReplacement sets replacement used by the Replace and KeepLast4 actions.
+func (redactor *Redactor) SetReplacement(replacement string) {
+	redactor.replacement = replacement
+}
+
+// RedactString applies value rules to the text, e.g. to the message of the log
+// record.
+func (redactor *Redactor) RedactString(text string) string {
+	for _, rule := range redactor.rules {
+		if rule.Value == nil {
+			continue
+		}
+		text = rule.Value.ReplaceAllStringFunc(text, func(match string) string {
+			return redactor.apply(rule.Action, match)
+		})
+	}
+	return text
+}
+
+// Redacted wraps value that has already been redacted, Redactor returns the
+// wrapped value as is without applying the rules again.
+type Redacted struct {
+	// Value is a redacted value.
+	Value any
+}
+
+// MatchesKey returns true, if any key rule matches the key.
+func (redactor *Redactor) MatchesKey(key string) bool {
+	for _, rule := range redactor.rules {
+		if rule.matchesKey(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactValue applies rules to the value of the key. It returns false, if value
+// shall be dropped. Hash and KeepLast4 key rules are applied to every element
+// of the slices of strings, so hashes of the elements match hashes of the same
+// strings, Replace replaces the whole slice. Value
+// rules are applied to strings, slices of strings and nested maps. Redacted
+// values are unwrapped without applying the rules.
+func (redactor *Redactor) RedactValue(key string, value any) (any, bool) {
+	if redacted, ok := value.(Redacted); ok {
+		return redacted.Value, true
+	}
+
+	for _, rule := range redactor.rules {
+		if rule.matchesKey(key) {
+			if rule.Action == Drop {
+				return nil, false
+			}
+			if items, ok := value.([]string); ok && rule.Action != Replace {
+				redacted := make([]string, len(items))
+				for index, item := range items {
+					redacted[index] = redactor.apply(rule.Action, item)
+				}
+				return redacted, true
+			}
+			return redactor.apply(rule.Action, fmt.Sprint(value)), true
+		}
+	}
+
+	switch convertedValue := value.(type) {
+	case string:
+		return redactor.RedactString(convertedValue), true
+	case []string:
+		redacted := make([]string, len(convertedValue))
+		for index, item := range convertedValue {
+			redacted[index] = redactor.RedactString(item)
+		}
+		return redacted, true
+	case map[string]interface{}:
+		return redactor.RedactMap(convertedValue), true
+	}
+	return value, true
+}
+
+// RedactMap returns copy of the parameters with applied redaction rules, the
+// original parameters are not modified.
+func (redactor *Redactor) RedactMap(parameters map[string]interface{}) map[string]interface{} {
+	redacted := make(map[string]interface{}, len(parameters))
+	for key, value := range parameters {
+		if redactedValue, ok := redactor.RedactValue(key, value); ok {
+			redacted[key] = redactedValue
+		}
+	}
+	return redacted
+}
+
+// apply applies action to the sensitive value.
+func (redactor *Redactor) apply(action Action, value string) string {
+	switch action {
+	case Drop:
+		return ""
+	case Hash:
+		sum := sha256.Sum256([]byte(redactor.salt + value))
+		return hex.EncodeToString(sum[:])
+	case KeepLast4:
+		characters := []rune(value)
+		if len(characters) <= 4 {
+			return redactor.replacement
+		}
+		return redactor.replacement + string(characters[len(characters)-4:])
+	default:
+		return redactor.replacement
+	}
+}
@@ -0,0 +1,92 @@
+// Package stdlog contains helpers used to bridge output of the standard library
+// log package and other io.Writer based APIs into the loggers.
+package stdlog
+
+import (
+	"bytes"
+	"github.com/dl1998/go-logging/pkg/common/level"
+	"strings"
+	"sync"
+)
+
+// aliases maps level names commonly used by other libraries onto the Level.
+var aliases = map[string]level.Level{
+	"warn":  level.Warning,
+	"err":   level.Error,
+	"crit":  level.Critical,
+	"fatal": level.Critical,
+	"panic": level.Critical,
+	"emerg": level.Emergency,
+}
+
+// ParseLevel returns Level from the prefix of the line and the rest of the
+// line. Prefix is a level name in brackets or followed by colon in any case,
+// e.g. "[error] message" or "Warn: message", or an upper case level name, e.g.
+// "INFO message", so ordinary words at the start of the line are not parsed.
+// Common aliases (e.g. "warn", "err", "fatal") are supported. It returns
+// defaultLevel and the line as is, if line does not start with a level.
+func ParseLevel(line string, defaultLevel level.Level) (level.Level, string) {
+	prefix, rest, _ := strings.Cut(strings.TrimLeft(line, " \t"), " ")
+
+	name, decorated := strings.CutSuffix(prefix, ":")
+	if strings.HasPrefix(name, "[") && strings.HasSuffix(name, "]") {
+		name, decorated = name[1:len(name)-1], true
+	}
+	if !decorated && name != strings.ToUpper(name) {
+		return defaultLevel, line
+	}
+	name = strings.ToLower(name)
+
+	parsedLevel, ok := aliases[name]
+	if !ok {
+		parsedLevel = level.ParseLevel(name)
+	}
+	if parsedLevel == level.Null || parsedLevel == level.All {
+		return defaultLevel, line
+	}
+	return parsedLevel, strings.TrimLeft(rest, " \t")
+}
+
+// LineBuffer collects written data and splits it into lines, incomplete line
+// is kept till the next write. It is safe for concurrent use.
+type LineBuffer struct {
+	mutex  sync.Mutex
+	buffer []byte
+}
+
+// Split appends data to the LineBuffer and returns complete lines without line
+// terminators ("\n" or "\r\n").
+func (buffer *LineBuffer) Split(data []byte) []string {
+	buffer.mutex.Lock()
+	defer buffer.mutex.Unlock()
+
+	buffer.buffer = append(buffer.buffer, data...)
+
+	var lines []string
+	for {
+		index := bytes.IndexByte(buffer.buffer, '\n')
+		if index < 0 {
+			break
+		}
+		lines = append(lines, string(bytes.TrimSuffix(buffer.buffer[:index], []byte{'\r'})))
+		buffer.buffer = buffer.buffer[index+1:]
+	}
+	if len(buffer.buffer) == 0 {
+		buffer.buffer = nil
+	}
+	return lines
+}
+
+// Flush returns incomplete line collected by the LineBuffer and resets it, ok
+// is false, if there is no incomplete line.
+func (buffer *LineBuffer) Flush() (line string, ok bool) {
+	buffer.mutex.Lock()
+	defer buffer.mutex.Unlock()
+
+	if len(buffer.buffer) == 0 {
+		return "", false
+	}
+	line = string(bytes.TrimSuffix(buffer.buffer, []byte{'\r'}))
+	buffer.buffer = nil
+	return line, true
+}
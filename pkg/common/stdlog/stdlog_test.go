@@ -0,0 +1,92 @@
+// Package stdlog contains tests for the standard library log bridge helpers.
+package stdlog
+
+import (
+	"github.com/dl1998/go-logging/internal/testutils"
+	"github.com/dl1998/go-logging/pkg/common/level"
+	"testing"
+)
+
+// TestParseLevel tests that ParseLevel returns level from the prefix of the
+// line and the rest of the line.
+func TestParseLevel(t *testing.T) {
+	tests := map[string]struct {
+		line          string
+		expectedLevel level.Level
+		expected      string
+	}{
+		"Brackets":        {line: "[ERROR] connection lost", expectedLevel: level.Error, expected: "connection lost"},
+		"Colon":           {line: "warn: disk is almost full", expectedLevel: level.Warning, expected: "disk is almost full"},
+		"Upper Case":      {line: "  DEBUG  cache miss", expectedLevel: level.Debug, expected: "cache miss"},
+		"Alias":           {line: "[fatal]: shutdown", expectedLevel: level.Critical, expected: "shutdown"},
+		"Ordinary Word":   {line: "error connecting to db", expectedLevel: level.Info, expected: "error connecting to db"},
+		"Unknown Prefix":  {line: "http: TLS handshake error", expectedLevel: level.Info, expected: "http: TLS handshake error"},
+		"Reserved Levels": {line: "[null] value", expectedLevel: level.Info, expected: "[null] value"},
+		"Empty":           {line: "", expectedLevel: level.Info, expected: ""},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			actualLevel, actual := ParseLevel(test.line, level.Info)
+
+			testutils.AssertEquals(t, test.expectedLevel, actualLevel)
+			testutils.AssertEquals(t, test.expected, actual)
+		})
+	}
+}
+
+// BenchmarkParseLevel performs benchmarking of the ParseLevel().
+func BenchmarkParseLevel(b *testing.B) {
+	for index := 0; index < b.N; index++ {
+		ParseLevel("[ERROR] connection lost", level.Info)
+	}
+}
+
+// TestLineBuffer_Split tests that LineBuffer.Split returns complete lines and
+// keeps incomplete line till the next write.
+func TestLineBuffer_Split(t *testing.T) {
+	buffer := &LineBuffer{}
+
+	testutils.AssertEquals(t, []string{"first", "second"}, buffer.Split([]byte("first\nsecond\r\nthi")))
+	testutils.AssertEquals(t, []string(nil), buffer.Split([]byte("rd")))
+	testutils.AssertEquals(t, []string{"third"}, buffer.Split([]byte("\n")))
+	testutils.AssertEquals(t, 0, len(buffer.buffer))
+}
+
+// BenchmarkLineBuffer_Split performs benchmarking of the LineBuffer.Split().
+func BenchmarkLineBuffer_Split(b *testing.B) {
+	buffer := &LineBuffer{}
+	data := []byte("message\n")
+
+	for index := 0; index < b.N; index++ {
+		buffer.Split(data)
+	}
+}
+
+// TestLineBuffer_Flush tests that LineBuffer.Flush returns incomplete line and
+// resets the LineBuffer.
+func TestLineBuffer_Flush(t *testing.T) {
+	buffer := &LineBuffer{}
+	buffer.Split([]byte("first\nsecond"))
+
+	line, ok := buffer.Flush()
+
+	testutils.AssertEquals(t, "second", line)
+	testutils.AssertEquals(t, true, ok)
+
+	line, ok = buffer.Flush()
+
+	testutils.AssertEquals(t, "", line)
+	testutils.AssertEquals(t, false, ok)
+}
+
+// BenchmarkLineBuffer_Flush performs benchmarking of the LineBuffer.Flush().
+func BenchmarkLineBuffer_Flush(b *testing.B) {
+	buffer := &LineBuffer{}
+	data := []byte("message")
+
+	for index := 0; index < b.N; index++ {
+		buffer.Split(data)
+		buffer.Flush()
+	}
+}
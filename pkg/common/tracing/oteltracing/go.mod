@@ -0,0 +1,10 @@
+module github.com/dl1998/go-logging/pkg/common/tracing/oteltracing
+
+go 1.22
+
+require (
+	github.com/dl1998/go-logging v0.0.0-20261019090314-86f38fab60dc
+	go.opentelemetry.io/otel/trace v1.28.0
+)
+
+require go.opentelemetry.io/otel v1.28.0 // indirect
@@ -0,0 +1,33 @@
+// Package oteltracing implements tracing.Extractor using OpenTelemetry API. It
+// is a separate module, so OpenTelemetry is not a dependency of the logger.
+package oteltracing
+
+import (
+	"context"
+	"github.com/dl1998/go-logging/pkg/common/tracing"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Extractor extracts span context of the OpenTelemetry span stored in the
+// context.
+type Extractor struct{}
+
+// SpanContext returns span context of the OpenTelemetry span stored in the
+// context, it returns false if context does not contain valid span.
+func (Extractor) SpanContext(ctx context.Context) (tracing.SpanContext, bool) {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.IsValid() {
+		return tracing.SpanContext{}, false
+	}
+	return tracing.SpanContext{
+		TraceID:    spanContext.TraceID().String(),
+		SpanID:     spanContext.SpanID().String(),
+		TraceFlags: spanContext.TraceFlags().String(),
+	}, true
+}
+
+// Register registers Extractor, so loggers derived using Ctx method contain
+// identifiers of the OpenTelemetry span stored in the context.
+func Register() error {
+	return tracing.Register(Extractor{})
+}
@@ -0,0 +1,66 @@
+// Package oteltracing contains tests for the OpenTelemetry extractor.
+package oteltracing
+
+import (
+	"context"
+	"github.com/dl1998/go-logging/internal/testutils"
+	"github.com/dl1998/go-logging/pkg/common/logcontext"
+	"github.com/dl1998/go-logging/pkg/common/tracing"
+	"go.opentelemetry.io/otel/trace"
+	"testing"
+)
+
+// spanContext is a span context used in tests.
+var spanContext = trace.NewSpanContext(trace.SpanContextConfig{
+	TraceID:    trace.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36},
+	SpanID:     trace.SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7},
+	TraceFlags: trace.FlagsSampled,
+})
+
+// TestExtractor_SpanContext tests that Extractor.SpanContext returns
+// identifiers of the span stored in the context.
+func TestExtractor_SpanContext(t *testing.T) {
+	ctx := trace.ContextWithSpanContext(context.Background(), spanContext)
+
+	actual, ok := Extractor{}.SpanContext(ctx)
+
+	expected := tracing.SpanContext{
+		TraceID:    "4bf92f3577b34da6a3ce929d0e0e4736",
+		SpanID:     "00f067aa0ba902b7",
+		TraceFlags: "01",
+	}
+
+	testutils.AssertEquals(t, true, ok)
+	testutils.AssertEquals(t, expected, actual)
+}
+
+// TestExtractor_SpanContext_Missing tests that Extractor.SpanContext returns
+// false, if context does not contain span.
+func TestExtractor_SpanContext_Missing(t *testing.T) {
+	_, ok := Extractor{}.SpanContext(context.Background())
+
+	testutils.AssertEquals(t, false, ok)
+}
+
+// TestRegister tests that Register adds identifiers of the span to the fields
+// extracted from the context.
+func TestRegister(t *testing.T) {
+	defer tracing.Unregister()
+
+	err := Register()
+
+	ctx := trace.ContextWithSpanContext(context.Background(), spanContext)
+
+	testutils.AssertNil(t, err)
+	testutils.AssertEquals(t, any("4bf92f3577b34da6a3ce929d0e0e4736"), logcontext.Fields(ctx)[tracing.TraceIDField])
+}
+
+// BenchmarkExtractor_SpanContext performs benchmarking of the
+// Extractor.SpanContext().
+func BenchmarkExtractor_SpanContext(b *testing.B) {
+	ctx := trace.ContextWithSpanContext(context.Background(), spanContext)
+
+	for index := 0; index < b.N; index++ {
+		Extractor{}.SpanContext(ctx)
+	}
+}
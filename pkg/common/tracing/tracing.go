@@ -0,0 +1,91 @@
+// Package tracing contains correlation of the log records with the traces. It
+// does not depend on any tracing library, span context is obtained using
+// Extractor, e.g. implemented by the oteltracing sub-package for OpenTelemetry.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"github.com/dl1998/go-logging/pkg/common/logcontext"
+)
+
+// Names of the fields added to the log records.
+const (
+	// TraceIDField is a name of the field that contains identifier of the
+	// trace.
+	TraceIDField = "trace_id"
+	// SpanIDField is a name of the field that contains identifier of the span.
+	SpanIDField = "span_id"
+	// TraceFlagsField is a name of the field that contains flags of the trace
+	// (e.g. "01" for the sampled trace).
+	TraceFlagsField = "trace_flags"
+)
+
+// ExtractorName is a name of the context extractor registered by Register.
+const ExtractorName = "tracing"
+
+// SpanContext contains identifiers of the span in the hex format.
+type SpanContext struct {
+	// TraceID is an identifier of the trace.
+	TraceID string
+	// SpanID is an identifier of the span.
+	SpanID string
+	// TraceFlags are flags of the trace, it could be empty.
+	TraceFlags string
+}
+
+// IsValid returns true, if SpanContext contains identifiers of the trace and
+// span.
+func (spanContext SpanContext) IsValid() bool {
+	return spanContext.TraceID != "" && spanContext.SpanID != ""
+}
+
+// Fields returns fields of the SpanContext added to the log records, empty trace
+// flags are omitted.
+func (spanContext SpanContext) Fields() map[string]interface{} {
+	fields := map[string]interface{}{
+		TraceIDField: spanContext.TraceID,
+		SpanIDField:  spanContext.SpanID,
+	}
+	if spanContext.TraceFlags != "" {
+		fields[TraceFlagsField] = spanContext.TraceFlags
+	}
+	return fields
+}
+
+// Extractor extracts span context from the context.Context.
+type Extractor interface {
+	// SpanContext returns span context stored in the context, it returns false
+	// if context does not contain span.
+	SpanContext(ctx context.Context) (SpanContext, bool)
+}
+
+// ExtractorFunc is an adapter that allows to use function as Extractor.
+type ExtractorFunc func(ctx context.Context) (SpanContext, bool)
+
+// SpanContext calls function with the context.
+func (function ExtractorFunc) SpanContext(ctx context.Context) (SpanContext, bool) {
+	return function(ctx)
+}
+
+// Register registers Extractor as a context extractor (see
+// logcontext.RegisterExtractor), so loggers derived using Ctx method contain
+// identifiers of the span stored in the context. Registration replaces the
+// previously registered Extractor.
+func Register(extractor Extractor) error {
+	if extractor == nil {
+		return fmt.Errorf("tracing extractor is nil")
+	}
+	return logcontext.RegisterExtractor(ExtractorName, func(ctx context.Context) map[string]interface{} {
+		spanContext, ok := extractor.SpanContext(ctx)
+		if !ok || !spanContext.IsValid() {
+			return nil
+		}
+		return spanContext.Fields()
+	})
+}
+
+// Unregister removes registered Extractor.
+func Unregister() {
+	logcontext.UnregisterExtractor(ExtractorName)
+}
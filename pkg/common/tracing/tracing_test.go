@@ -0,0 +1,109 @@
+// Package tracing contains tests for the correlation with traces.
+package tracing
+
+import (
+	"context"
+	"github.com/dl1998/go-logging/internal/testutils"
+	"github.com/dl1998/go-logging/pkg/common/logcontext"
+	"testing"
+)
+
+// spanKey is a key of the fake span context stored in the context.
+type spanKey struct{}
+
+// fakeExtractor is an Extractor of the fake span context stored in the context.
+var fakeExtractor = ExtractorFunc(func(ctx context.Context) (SpanContext, bool) {
+	spanContext, ok := ctx.Value(spanKey{}).(SpanContext)
+	return spanContext, ok
+})
+
+// fakeSpanContext is a span context used in tests.
+var fakeSpanContext = SpanContext{
+	TraceID:    "4bf92f3577b34da6a3ce929d0e0e4736",
+	SpanID:     "00f067aa0ba902b7",
+	TraceFlags: "01",
+}
+
+// TestSpanContext_IsValid tests that SpanContext.IsValid returns true only if
+// both identifiers are set.
+func TestSpanContext_IsValid(t *testing.T) {
+	testutils.AssertEquals(t, true, fakeSpanContext.IsValid())
+	testutils.AssertEquals(t, false, SpanContext{TraceID: fakeSpanContext.TraceID}.IsValid())
+	testutils.AssertEquals(t, false, SpanContext{}.IsValid())
+}
+
+// TestSpanContext_Fields tests that SpanContext.Fields returns identifiers and
+// omits empty trace flags.
+func TestSpanContext_Fields(t *testing.T) {
+	expected := map[string]interface{}{
+		TraceIDField:    fakeSpanContext.TraceID,
+		SpanIDField:     fakeSpanContext.SpanID,
+		TraceFlagsField: fakeSpanContext.TraceFlags,
+	}
+
+	testutils.AssertEquals(t, expected, fakeSpanContext.Fields())
+
+	withoutFlags := SpanContext{TraceID: fakeSpanContext.TraceID, SpanID: fakeSpanContext.SpanID}
+
+	delete(expected, TraceFlagsField)
+
+	testutils.AssertEquals(t, expected, withoutFlags.Fields())
+}
+
+// BenchmarkSpanContext_Fields performs benchmarking of the
+// SpanContext.Fields().
+func BenchmarkSpanContext_Fields(b *testing.B) {
+	for index := 0; index < b.N; index++ {
+		fakeSpanContext.Fields()
+	}
+}
+
+// TestRegister tests that Register adds identifiers of the span to the fields
+// extracted from the context.
+func TestRegister(t *testing.T) {
+	defer Unregister()
+
+	err := Register(fakeExtractor)
+
+	testutils.AssertNil(t, err)
+	testutils.AssertEquals(t, []string{ExtractorName}, logcontext.Extractors())
+
+	ctx := context.WithValue(context.Background(), spanKey{}, fakeSpanContext)
+
+	testutils.AssertEquals(t, fakeSpanContext.Fields(), logcontext.Fields(ctx))
+	testutils.AssertNil(t, logcontext.Fields(context.Background()))
+
+	invalid := context.WithValue(context.Background(), spanKey{}, SpanContext{})
+
+	testutils.AssertNil(t, logcontext.Fields(invalid))
+}
+
+// TestRegister_Error tests that Register returns error for nil Extractor.
+func TestRegister_Error(t *testing.T) {
+	testutils.AssertNotNil(t, Register(nil))
+}
+
+// TestUnregister tests that Unregister removes registered Extractor.
+func TestUnregister(t *testing.T) {
+	_ = Register(fakeExtractor)
+
+	Unregister()
+
+	testutils.AssertEquals(t, []string{}, logcontext.Extractors())
+}
+
+// BenchmarkRegister performs benchmarking of the extraction of the span
+// context by the registered Extractor.
+func BenchmarkRegister(b *testing.B) {
+	defer Unregister()
+
+	_ = Register(fakeExtractor)
+
+	ctx := context.WithValue(context.Background(), spanKey{}, fakeSpanContext)
+
+	b.ResetTimer()
+
+	for index := 0; index < b.N; index++ {
+		logcontext.Fields(ctx)
+	}
+}
@@ -2,7 +2,9 @@ package logger
 
 import (
 	"fmt"
+	"github.com/dl1998/go-logging/pkg/common/lazy"
 	"github.com/dl1998/go-logging/pkg/common/level"
+	commonlogrecord "github.com/dl1998/go-logging/pkg/common/logrecord"
 	"github.com/dl1998/go-logging/pkg/logger/handler"
 	"github.com/dl1998/go-logging/pkg/logger/logrecord"
 	"sync"
@@ -56,8 +58,18 @@ func (logger *baseAsyncLogger) Close() {
 
 // Log logs interpolated message with the provided level.Level.
 func (logger *baseAsyncLogger) Log(level level.Level, skipCallers int, message string, parameters ...any) {
+	logger.LogFields(level, skipCallers+1, nil, message, parameters...)
+}
+
+// LogFields logs interpolated message with the provided level.Level and
+// contextual fields bound to the logger.
+func (logger *baseAsyncLogger) LogFields(level level.Level, skipCallers int, fields map[string]interface{}, message string, parameters ...any) {
+	if !logger.Enabled(level) {
+		return
+	}
 	logger.waitGroup.Add(1)
-	record := logrecord.New(logger.name, level, logger.timeFormat, message, parameters, skipCallers)
+	message, parameters = logger.redact(message, lazy.Slice(parameters))
+	record := logrecord.New(logger.name, level, logger.timeFormat, message, parameters, skipCallers, commonlogrecord.WithLocation(logger.location), commonlogrecord.WithCaller(!logger.withoutCaller), commonlogrecord.WithGoroutineID(logger.withGoroutine), commonlogrecord.WithClock(logger.clock), commonlogrecord.WithStack(logger.capturesStack(level)), commonlogrecord.WithFields(logger.redactFields(lazy.Map(fields))))
 	logger.messageQueue <- record
 }
 
@@ -81,7 +93,10 @@ func NewAsyncLogger(name string, timeFormat string, queueSize int) *AsyncLogger
 		baseLogger: &baseLogger{
 			name:       name,
 			timeFormat: timeFormat,
+			stackLevel: level.Null,
+			clock:      commonlogrecord.NewClock(),
 			handlers:   make([]handler.Interface, 0),
+			propagate:  true,
 		},
 		messageQueue:  make(chan logrecord.Interface, queueSize),
 		isChannelOpen: true,
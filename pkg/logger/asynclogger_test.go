@@ -128,6 +128,21 @@ func TestBaseAsyncLogger_Log(t *testing.T) {
 	testutils.AssertEquals(t, fmt.Sprintf(message, parameters...), record.Message())
 }
 
+// TestBaseAsyncLogger_LogFields tests that baseAsyncLogger.LogFields sends a new
+// record with bound fields on the message queue.
+func TestBaseAsyncLogger_LogFields(t *testing.T) {
+	mockHandler := &MockHandler{}
+	newBaseAsyncLogger := createBaseAsyncLogger([]handler.Interface{mockHandler}, messageQueueSize, true)
+
+	fields := map[string]interface{}{"request_id": "abc"}
+
+	newBaseAsyncLogger.LogFields(logLevel, skipCallers, fields, message, parameters...)
+	record := <-newBaseAsyncLogger.messageQueue
+
+	testutils.AssertEquals(t, fmt.Sprintf(message, parameters...), record.Message())
+	testutils.AssertEquals(t, fields, record.Fields())
+}
+
 // BenchmarkBaseAsyncLogger_Log benchmarks baseAsyncLogger.Log method of the
 // baseAsyncLogger.
 func BenchmarkBaseAsyncLogger_Log(b *testing.B) {
@@ -180,6 +195,22 @@ func TestAsyncLogger_WaitToFinishLogging(t *testing.T) {
 	testutils.AssertEquals(t, true, waited)
 }
 
+// TestAsyncLogger_With tests that Logger derived from the AsyncLogger sends
+// records with bound fields on the message queue of the AsyncLogger.
+func TestAsyncLogger_With(t *testing.T) {
+	mockHandler := &MockHandler{}
+	newAsyncLogger := &AsyncLogger{
+		Logger: &Logger{
+			baseLogger: createBaseAsyncLogger([]handler.Interface{mockHandler}, messageQueueSize, true),
+		},
+	}
+
+	newAsyncLogger.With("request_id", "abc").Debug(message, parameters...)
+	record := <-newAsyncLogger.baseLogger.(*baseAsyncLogger).messageQueue
+
+	testutils.AssertEquals(t, map[string]interface{}{"request_id": "abc"}, record.Fields())
+}
+
 // TestAsyncLogger_Open tests that AsyncLogger.Open creates a new message queue
 // and start listening messages.
 func TestAsyncLogger_Open(t *testing.T) {
@@ -1,33 +1,98 @@
 package logger
 
 import (
+	"fmt"
+	commonformatter "github.com/dl1998/go-logging/pkg/common/formatter"
+	"github.com/dl1998/go-logging/pkg/common/lazy"
 	"github.com/dl1998/go-logging/pkg/common/level"
+	commonlogrecord "github.com/dl1998/go-logging/pkg/common/logrecord"
+	"github.com/dl1998/go-logging/pkg/common/redaction"
 	"github.com/dl1998/go-logging/pkg/logger/handler"
 	"github.com/dl1998/go-logging/pkg/logger/logrecord"
+	"maps"
+	"time"
 )
 
 // baseLoggerInterface defines low level logging interface.
 type baseLoggerInterface interface {
 	Log(level level.Level, skipCallers int, message string, parameters ...any)
+	LogFields(level level.Level, skipCallers int, fields map[string]interface{}, message string, parameters ...any)
 	Name() string
 	SetName(name string)
 	Handlers() []handler.Interface
 	AddHandler(handlerInterface handler.Interface)
 	RemoveHandler(handlerInterface handler.Interface)
+	Location() *time.Location
+	SetLocation(location *time.Location)
+	StackLevel() level.Level
+	SetStackLevel(stackLevel level.Level)
+	Redactor() *redaction.Redactor
+	SetRedactor(redactor *redaction.Redactor)
+	Level() level.Level
+	SetLevel(minimumLevel level.Level)
+	EffectiveLevel() level.Level
+	Enabled(logLevel level.Level) bool
+	Propagate() bool
+	SetPropagate(propagate bool)
 }
 
 // baseLogger struct contains basic fields for the logger.
 type baseLogger struct {
 	name       string
 	timeFormat string
+	location   *time.Location
+	stackLevel level.Level
 	handlers   []handler.Interface
+	// withoutCaller defines whether lookup of the caller is skipped, it is
+	// updated on every change of the handlers.
+	withoutCaller bool
+	// withGoroutine defines whether identifier of the goroutine is captured,
+	// it is updated on every change of the handlers.
+	withGoroutine bool
+	// clock is used to compute time elapsed since creation of the logger and
+	// since its previous log record.
+	clock *commonlogrecord.Clock
+	// redactor applies redaction rules to the log records, nil disables
+	// redaction.
+	redactor *redaction.Redactor
+	// level is a minimum level of the log records, level.All means that level
+	// is inherited from the parent logger.
+	level level.Level
+	// propagate defines whether log records are passed to the handlers of the
+	// parent logger.
+	propagate bool
+	// parent is a parent of the logger in the hierarchy of the named loggers,
+	// it is nil for the top-level loggers.
+	parent *baseLogger
+	// hierarchical defines whether logger belongs to the hierarchy of the
+	// named loggers, top-level loggers of the hierarchy are children of the
+	// default logger.
+	hierarchical bool
 }
 
 // Log logs interpolated message with the provided level.Level.
 func (logger *baseLogger) Log(level level.Level, skipCallers int, message string, parameters ...any) {
-	record := logrecord.New(logger.name, level, logger.timeFormat, message, parameters, skipCallers)
-	for _, registeredHandler := range logger.handlers {
-		registeredHandler.Write(record)
+	logger.LogFields(level, skipCallers+1, nil, message, parameters...)
+}
+
+// LogFields logs interpolated message with the provided level.Level and
+// contextual fields bound to the logger.
+func (logger *baseLogger) LogFields(level level.Level, skipCallers int, fields map[string]interface{}, message string, parameters ...any) {
+	if !logger.Enabled(level) {
+		return
+	}
+	message, parameters = logger.redact(message, lazy.Slice(parameters))
+	record := logrecord.New(logger.name, level, logger.timeFormat, message, parameters, skipCallers, commonlogrecord.WithLocation(logger.location), commonlogrecord.WithCaller(logger.capturesCaller()), commonlogrecord.WithGoroutineID(logger.capturesGoroutine()), commonlogrecord.WithClock(logger.clock), commonlogrecord.WithStack(logger.capturesStack(level)), commonlogrecord.WithFields(logger.redactFields(lazy.Map(fields))))
+	logger.write(record)
+}
+
+// write writes log record to the handlers of the logger and, if propagation
+// is enabled, to the handlers of its ancestors.
+func (logger *baseLogger) write(record logrecord.Interface) {
+	for node := logger; node != nil; node = node.propagationParent() {
+		for _, registeredHandler := range node.handlers {
+			registeredHandler.Write(record)
+		}
 	}
 }
 
@@ -41,6 +106,163 @@ func (logger *baseLogger) SetName(name string) {
 	logger.name = name
 }
 
+// Location returns location used for the time of the log records, nil means
+// local time.
+func (logger *baseLogger) Location() *time.Location {
+	return logger.location
+}
+
+// SetLocation sets location used for the time of the log records, nil means
+// local time.
+func (logger *baseLogger) SetLocation(location *time.Location) {
+	logger.location = location
+}
+
+// StackLevel returns level starting from which stack of the goroutine is
+// captured, level.Null means that stack is never captured.
+func (logger *baseLogger) StackLevel() level.Level {
+	return logger.stackLevel
+}
+
+// SetStackLevel sets level starting from which stack of the goroutine is
+// captured, level.Null disables capturing of the stack.
+func (logger *baseLogger) SetStackLevel(stackLevel level.Level) {
+	logger.stackLevel = stackLevel
+}
+
+// Redactor returns redactor applied to the log records, nil means that
+// redaction is disabled.
+func (logger *baseLogger) Redactor() *redaction.Redactor {
+	return logger.redactor
+}
+
+// SetRedactor sets redactor applied to the log records, nil disables
+// redaction.
+func (logger *baseLogger) SetRedactor(redactor *redaction.Redactor) {
+	logger.redactor = redactor
+}
+
+// redact applies value rules of the redactor to the interpolated message, it
+// returns message and parameters unchanged, if redaction is disabled.
+func (logger *baseLogger) redact(message string, parameters []any) (string, []any) {
+	if logger.redactor == nil {
+		return message, parameters
+	}
+	return "%s", []any{logger.redactor.RedactString(fmt.Sprintf(message, parameters...))}
+}
+
+// redactFields applies redaction rules to the bound fields, it returns fields
+// unchanged, if redaction is disabled.
+func (logger *baseLogger) redactFields(fields map[string]interface{}) map[string]interface{} {
+	if logger.redactor == nil || len(fields) == 0 {
+		return fields
+	}
+	return logger.redactor.RedactMap(fields)
+}
+
+// capturesStack returns true, if stack shall be captured for the log record
+// with the provided level.
+func (logger *baseLogger) capturesStack(logLevel level.Level) bool {
+	return logger.stackLevel < level.Null && logLevel >= logger.stackLevel
+}
+
+// Level returns minimum level of the log records, level.All means that level
+// is inherited from the parent logger.
+func (logger *baseLogger) Level() level.Level {
+	return logger.level
+}
+
+// SetLevel sets minimum level of the log records, level.All means that level
+// is inherited from the parent logger.
+func (logger *baseLogger) SetLevel(minimumLevel level.Level) {
+	logger.level = minimumLevel
+}
+
+// EffectiveLevel returns level of the logger or of its nearest ancestor with
+// configured level, level.All means that records are not filtered.
+func (logger *baseLogger) EffectiveLevel() level.Level {
+	for node := logger; node != nil; node = node.parentLogger() {
+		if node.level != level.All {
+			return node.level
+		}
+	}
+	return level.All
+}
+
+// Enabled returns true, if log record with the provided level passes the
+// effective level of the logger and is accepted by at least one handler that
+// receives log records of the logger. Without effective level the minimum is
+// derived from the handlers.
+func (logger *baseLogger) Enabled(logLevel level.Level) bool {
+	return logLevel >= logger.EffectiveLevel() && logger.accepts(logLevel)
+}
+
+// accepts returns true, if any handler that receives log records of the logger
+// accepts log records with the provided level.
+func (logger *baseLogger) accepts(logLevel level.Level) bool {
+	for node := logger; node != nil; node = node.propagationParent() {
+		for _, registeredHandler := range node.handlers {
+			if logLevel >= registeredHandler.FromLevel() && logLevel <= registeredHandler.ToLevel() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Propagate returns true, if log records are passed to the handlers of the
+// parent logger.
+func (logger *baseLogger) Propagate() bool {
+	return logger.propagate
+}
+
+// SetPropagate sets whether log records are passed to the handlers of the
+// parent logger.
+func (logger *baseLogger) SetPropagate(propagate bool) {
+	logger.propagate = propagate
+}
+
+// parentLogger returns parent of the logger in the hierarchy of the named
+// loggers, top-level loggers return the default logger. It returns nil for
+// loggers outside of the hierarchy.
+func (logger *baseLogger) parentLogger() *baseLogger {
+	if logger.parent != nil || !logger.hierarchical {
+		return logger.parent
+	}
+	return defaultBaseLogger()
+}
+
+// propagationParent returns parent logger that receives log records of the
+// logger, it returns nil if propagation is disabled.
+func (logger *baseLogger) propagationParent() *baseLogger {
+	if !logger.propagate {
+		return nil
+	}
+	return logger.parentLogger()
+}
+
+// capturesCaller returns true, if formatter of any handler that receives log
+// records of the logger uses caller information.
+func (logger *baseLogger) capturesCaller() bool {
+	for node := logger; node != nil; node = node.propagationParent() {
+		if !node.withoutCaller {
+			return true
+		}
+	}
+	return false
+}
+
+// capturesGoroutine returns true, if formatter of any handler that receives
+// log records of the logger uses identifier of the goroutine.
+func (logger *baseLogger) capturesGoroutine() bool {
+	for node := logger; node != nil; node = node.propagationParent() {
+		if node.withGoroutine {
+			return true
+		}
+	}
+	return false
+}
+
 // Handlers returns a list of the registered handler.Interface objects for the
 // baseLogger.
 func (logger *baseLogger) Handlers() []handler.Interface {
@@ -50,6 +272,9 @@ func (logger *baseLogger) Handlers() []handler.Interface {
 // AddHandler register a new handler.Interface for the baseLogger.
 func (logger *baseLogger) AddHandler(handlerInterface handler.Interface) {
 	logger.handlers = append(logger.handlers, handlerInterface)
+	firstHandler := len(logger.handlers) == 1
+	logger.withoutCaller = (firstHandler || logger.withoutCaller) && !handlerUsesCaller(handlerInterface)
+	logger.withGoroutine = (!firstHandler && logger.withGoroutine) || handlerUsesGoroutine(handlerInterface)
 }
 
 // RemoveHandler removes a handler.Interface from the baseLogger handlers.
@@ -61,4 +286,91 @@ func (logger *baseLogger) RemoveHandler(handlerInterface handler.Interface) {
 		}
 	}
 	logger.handlers = newSlice
+	logger.updateCaptures()
+}
+
+// updateCaptures checks whether any formatter of the registered handlers uses
+// caller information or identifier of the goroutine and disables their lookup,
+// if they are not used.
+func (logger *baseLogger) updateCaptures() {
+	logger.withoutCaller = true
+	logger.withGoroutine = false
+	for _, registeredHandler := range logger.handlers {
+		if handlerUsesCaller(registeredHandler) {
+			logger.withoutCaller = false
+		}
+		if handlerUsesGoroutine(registeredHandler) {
+			logger.withGoroutine = true
+		}
+	}
+}
+
+// handlerUsesCaller returns true, if formatter of the handler uses caller
+// information.
+func handlerUsesCaller(handlerInterface handler.Interface) bool {
+	return handlerInterface != nil && commonformatter.UsesCaller(handlerInterface.Formatter())
+}
+
+// handlerUsesGoroutine returns true, if formatter of the handler uses
+// identifier of the goroutine.
+func handlerUsesGoroutine(handlerInterface handler.Interface) bool {
+	return handlerInterface != nil && commonformatter.UsesGoroutine(handlerInterface.Formatter())
+}
+
+// boundLogger wraps baseLoggerInterface and attaches contextual fields to every
+// log record. It shares handlers and settings with the wrapped logger. Fields
+// are never modified after creation, so boundLogger is safe for concurrent use.
+type boundLogger struct {
+	baseLoggerInterface
+	// fields are contextual fields bound to the logger.
+	fields map[string]interface{}
+}
+
+// bindFields returns baseLoggerInterface that attaches fields to every log
+// record. Fields bound to the parent logger are merged with the new fields, new
+// fields take precedence.
+func bindFields(parent baseLoggerInterface, fields map[string]interface{}) baseLoggerInterface {
+	merged := make(map[string]interface{}, len(fields))
+	if bound, ok := parent.(*boundLogger); ok {
+		parent = bound.baseLoggerInterface
+		maps.Copy(merged, bound.fields)
+	}
+	maps.Copy(merged, fields)
+	return &boundLogger{baseLoggerInterface: parent, fields: merged}
+}
+
+// Log logs interpolated message with the provided level.Level and bound fields.
+func (logger *boundLogger) Log(level level.Level, skipCallers int, message string, parameters ...any) {
+	logger.baseLoggerInterface.LogFields(level, skipCallers+1, logger.fields, message, parameters...)
+}
+
+// LogFields logs interpolated message with the provided level.Level, bound
+// fields are merged with the provided fields.
+func (logger *boundLogger) LogFields(level level.Level, skipCallers int, fields map[string]interface{}, message string, parameters ...any) {
+	if !logger.Enabled(level) {
+		return
+	}
+	merged := maps.Clone(logger.fields)
+	maps.Copy(merged, fields)
+	logger.baseLoggerInterface.LogFields(level, skipCallers+1, merged, message, parameters...)
+}
+
+// convertParametersToMap converts key-value pairs or a single map to
+// map[string]interface{}.
+func convertParametersToMap(parameters ...any) map[string]interface{} {
+	var parametersMap = make(map[string]interface{})
+	parametersCount := len(parameters)
+
+	if parametersCount == 1 {
+		parametersMap = parameters[0].(map[string]interface{})
+	} else if parametersCount > 1 {
+		if parametersCount%2 != 0 {
+			parametersCount--
+		}
+		for index := 0; index < parametersCount; index += 2 {
+			parametersMap[parameters[index].(string)] = parameters[index+1]
+		}
+	}
+
+	return parametersMap
 }
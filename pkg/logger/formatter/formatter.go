@@ -1,47 +1,81 @@
-// Package formatter contains formatter that interpolates template strings and
-// formats them.
+// Package formatter contains formatters that interpolate template strings and
+// format them.
 package formatter
 
 import (
+	"bytes"
+	"fmt"
 	commonformatter "github.com/dl1998/go-logging/pkg/common/formatter"
 	"github.com/dl1998/go-logging/pkg/common/level"
 	"github.com/dl1998/go-logging/pkg/logger/logrecord"
 	"strconv"
 	"strings"
+	"sync"
+	textTemplate "text/template"
 )
 
-// logLevelColors maps level.Level values to ANSI color codes.
-var logLevelColors = map[level.Level]string{
-	level.Trace:     "\033[90m",         // Dark Grey
-	level.Debug:     "\033[36m",         // Cyan
-	level.Verbose:   "\033[96m",         // Light Cyan
-	level.Info:      "\033[97m",         // Default terminal text color (ANSI Bright White)
-	level.Notice:    "\033[94m",         // Light Blue
-	level.Warning:   "\033[93m",         // Bright Yellow
-	level.Severe:    "\033[38;5;208m",   // Orange
-	level.Error:     "\033[31m",         // Red
-	level.Alert:     "\033[38;5;202m",   // Dark Orange
-	level.Critical:  "\033[1;31m",       // Red or magenta (ANSI Bright Magenta)
-	level.Emergency: "\033[97m\033[41m", // Bright White on Red Background
-}
-
-// Reset color
-const resetColor = "\033[0m"
-
 // Interface represents interface that shall be satisfied by Formatter.
 type Interface interface {
 	Template() string
 	Format(record logrecord.Interface, colored bool) string
 }
 
+// maxPooledBufferSize is a maximum capacity of the buffer returned to the
+// bufferPool, larger buffers are dropped, so a single huge log record does not
+// keep memory allocated.
+const maxPooledBufferSize = 16 << 10
+
+// bufferPool contains buffers reused for the formatting of the log records.
+var bufferPool = sync.Pool{
+	New: func() any {
+		return new(bytes.Buffer)
+	},
+}
+
+// putBuffer returns buffer to the bufferPool, if its capacity does not exceed
+// maxPooledBufferSize.
+func putBuffer(buffer *bytes.Buffer) {
+	if buffer.Cap() > maxPooledBufferSize {
+		return
+	}
+	bufferPool.Put(buffer)
+}
+
 // Formatter struct that contains necessary for the formatting fields.
 type Formatter struct {
+	// Sanitization contains sanitization mode of the messages.
+	commonformatter.Sanitization
+	// Limitation contains limits of the messages and formatted log records.
+	commonformatter.Limitation
+	// Coloring contains color scheme of the colored output.
+	commonformatter.Coloring
+	// template is a template string used by formatter.
 	template string
+	// compiled is a template compiled into the sequence of segments.
+	compiled *commonformatter.Template
+	// usesCaller defines whether template uses caller information.
+	usesCaller bool
 }
 
-// New create a new instance of the Formatter.
+// New create a new instance of the Formatter. It panics if template contains
+// invalid format specifier.
 func New(template string) *Formatter {
-	return &Formatter{template: template}
+	compiled, err := commonformatter.CompileTemplate(template)
+	if err != nil {
+		panic(err)
+	}
+	return &Formatter{template: template, compiled: compiled, usesCaller: compiled.UsesCaller("message")}
+}
+
+// UsesCaller returns true, if template uses caller information of the log
+// record, e.g. "%(fname)" or "%(fline)".
+func (formatter *Formatter) UsesCaller() bool {
+	return formatter.usesCaller
+}
+
+// UsesGoroutine returns true, if template uses identifier of the goroutine.
+func (formatter *Formatter) UsesGoroutine() bool {
+	return formatter.compiled.Uses(commonformatter.GoroutinePlaceholder)
 }
 
 // IsEqual checks that two formatters are the same and returns result of the
@@ -57,26 +91,160 @@ func (formatter *Formatter) Template() string {
 
 // Format formats provided message template to the interpolated string.
 func (formatter *Formatter) Format(record logrecord.Interface, colored bool) string {
-	format := ParseTemplate(formatter.template, record)
+	record = SanitizeRecord(record, formatter.SanitizeMode())
+	record = LimitRecord(record, formatter.Limits())
+
+	buffer := bufferPool.Get().(*bytes.Buffer)
+	buffer.Reset()
+	defer putBuffer(buffer)
 
+	var lineColor, highlightColor commonformatter.Color
+	var highlight string
 	if colored {
-		format = logLevelColors[record.Level()] + format + resetColor
+		scheme := formatter.ColorScheme()
+		switch scheme.ModeOr(commonformatter.ColorLine) {
+		case commonformatter.ColorLevel:
+			highlight, highlightColor = "level", scheme.Level(record.Level())
+		case commonformatter.ColorMessage:
+			highlight, highlightColor = "message", scheme.Level(record.Level())
+		default:
+			lineColor = scheme.Level(record.Level())
+		}
+	}
+
+	buffer.WriteString(string(lineColor))
+
+	start := buffer.Len()
+	render(buffer, formatter.compiled, record, highlight, highlightColor)
+	fitBuffer(buffer, start, formatter.Limits())
+
+	if lineColor != "" {
+		buffer.WriteString(commonformatter.ColorReset)
+	}
+
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
+
+// messageRecord is a log record with sanitized or truncated message.
+type messageRecord struct {
+	logrecord.Interface
+	// message is a sanitized or truncated message of the log record.
+	message string
+}
+
+// Message returns sanitized or truncated message of the log record.
+func (record *messageRecord) Message() string {
+	return record.message
+}
+
+// SanitizeRecord returns log record with message sanitized according to the
+// mode. Record is returned as is, if message does not need sanitization.
+func SanitizeRecord(record logrecord.Interface, mode commonformatter.SanitizeMode) logrecord.Interface {
+	if mode <= commonformatter.SanitizeNone {
+		return record
+	}
+	message := record.Message()
+	sanitized := commonformatter.Sanitize(message, mode)
+	if sanitized == message {
+		return record
+	}
+	return &messageRecord{Interface: record, message: sanitized}
+}
+
+// LimitRecord returns log record with message cut to the MaxValueLength of the
+// limits. Record is returned as is, if message does not exceed limit.
+func LimitRecord(record logrecord.Interface, limits commonformatter.Limits) logrecord.Interface {
+	if limits.MaxValueLength <= 0 {
+		return record
+	}
+	message, truncated := limits.LimitString(record.Message())
+	if !truncated {
+		return record
+	}
+	return &messageRecord{Interface: record, message: message}
+}
+
+// fitBuffer cuts log record written into the buffer after the start to the
+// MaxRecordSize of the limits.
+func fitBuffer(buffer *bytes.Buffer, start int, limits commonformatter.Limits) {
+	if limits.MaxRecordSize <= 0 || buffer.Len()-start <= limits.MaxRecordSize {
+		return
+	}
+	fitted, _ := limits.FitRecord(string(buffer.Bytes()[start:]))
+	buffer.Truncate(start)
+	buffer.WriteString(fitted)
+}
+
+// render writes compiled template interpolated with values from the log record
+// into the buffer. Values are written as is, so they are never interpreted as
+// template. Values of the highlight key are wrapped into the color.
+func render(buffer *bytes.Buffer, compiled *commonformatter.Template, record logrecord.Interface, highlight string, color commonformatter.Color) {
+	for _, segment := range compiled.Segments() {
+		if !segment.IsPlaceholder() {
+			buffer.WriteString(segment.Text)
+			continue
+		}
+
+		value, ok := keyValue(segment, record)
+		if !ok {
+			buffer.WriteString(segment.Text)
+			continue
+		}
+
+		highlighted := color != "" && segment.Key == highlight
+		if highlighted {
+			buffer.WriteString(string(color))
+		}
+
+		if segment.Specifier != nil {
+			buffer.WriteString(segment.Specifier.Format(value))
+		} else {
+			writeValue(buffer, value)
+		}
+
+		if highlighted {
+			buffer.WriteString(commonformatter.ColorReset)
+		}
+	}
+}
+
+// keyValue returns value of the placeholder segment from the log record, it
+// returns false if the key is unknown.
+func keyValue(segment commonformatter.Segment, record logrecord.Interface) (interface{}, bool) {
+	if segment.Key == "message" {
+		return record.Message(), true
 	}
+	return commonformatter.SegmentValue(segment, record)
+}
 
-	return format + "\n"
+// writeValue writes string representation of the value into the buffer.
+func writeValue(buffer *bytes.Buffer, value interface{}) {
+	switch convertedValue := value.(type) {
+	case string:
+		buffer.WriteString(convertedValue)
+	case int:
+		buffer.Write(strconv.AppendInt(buffer.AvailableBuffer(), int64(convertedValue), 10))
+	case int64:
+		buffer.Write(strconv.AppendInt(buffer.AvailableBuffer(), convertedValue, 10))
+	default:
+		buffer.WriteString(fmt.Sprint(value))
+	}
 }
 
-// ParseTemplate parses template string and replaces keys with values from the log record.
+// ParseTemplate parses template string and replaces keys with values from the
+// log record. Keys could contain format specifier, e.g. "%(level:-8s)". Values
+// are not interpreted as template. For the repeated formatting prefer
+// Formatter, which compiles template only once.
 func ParseTemplate(format string, record logrecord.Interface) string {
-	format = ReplaceKey(format, "%(name)", record)
-	format = ReplaceKey(format, "%(level)", record)
-	format = ReplaceKey(format, "%(levelnr)", record)
-	format = ReplaceKey(format, "%(datetime)", record)
-	format = ReplaceKey(format, "%(timestamp)", record)
-	format = ReplaceKey(format, "%(fname)", record)
-	format = ReplaceKey(format, "%(fline)", record)
-	format = ReplaceKey(format, "%(message)", record)
-	return format
+	compiled, _ := commonformatter.CompileTemplate(format)
+
+	var buffer bytes.Buffer
+
+	render(&buffer, compiled, record, "", "")
+
+	return buffer.String()
 }
 
 // ReplaceKey replaces key with value from the log record.
@@ -90,20 +258,106 @@ func ReplaceKey(format string, key string, record logrecord.Interface) string {
 
 // ParseKey parses the key and returns the value.
 func ParseKey(key string, record logrecord.Interface) string {
-	switch key {
-	case "%(message)":
-		return record.Message()
+	var value interface{}
+
+	if name, specifier, ok := commonformatter.ParsePlaceholder(key); ok && name == "message" {
+		value = commonformatter.ApplySpecifier(key, specifier, record.Message())
+	} else {
+		value = commonformatter.ParseKey(key, record)
+	}
+
+	switch convertedValue := value.(type) {
+	case int64:
+		return strconv.FormatInt(convertedValue, 10)
+	case int:
+		return strconv.Itoa(convertedValue)
+	case string:
+		return convertedValue
 	default:
-		value := commonformatter.ParseKey(key, record)
-		switch convertedValue := value.(type) {
-		case int64:
-			return strconv.FormatInt(convertedValue, 10)
-		case int:
-			return strconv.Itoa(convertedValue)
-		case string:
-			return convertedValue
-		default:
-			return value.(string)
-		}
+		return fmt.Sprint(value)
 	}
 }
+
+// TemplateFormatter struct that contains necessary for the formatting with Go
+// text/template fields.
+type TemplateFormatter struct {
+	// Sanitization contains sanitization mode of the messages.
+	commonformatter.Sanitization
+	// Limitation contains limits of the messages and formatted log records.
+	commonformatter.Limitation
+	// Coloring contains color scheme of the colored output.
+	commonformatter.Coloring
+	// template is a text/template string used by formatter.
+	template string
+	// plain is a parsed template used for the non-colored output.
+	plain *textTemplate.Template
+	// colored is a parsed template used for the colored output.
+	colored *textTemplate.Template
+	// usesCaller is true, if template references caller information.
+	usesCaller bool
+	// usesGoroutine is true, if template references identifier of the
+	// goroutine.
+	usesGoroutine bool
+}
+
+// NewTemplate create a new instance of the TemplateFormatter. It panics if
+// template could not be parsed.
+func NewTemplate(text string) *TemplateFormatter {
+	newFormatter := &TemplateFormatter{template: text}
+	newFormatter.plain = textTemplate.Must(textTemplate.New("plain").Funcs(commonformatter.TemplateFunctions(func(_ level.Level, text string) string {
+		return text
+	})).Parse(text))
+	newFormatter.colored = textTemplate.Must(textTemplate.New("colored").Funcs(commonformatter.TemplateFunctions(func(logLevel level.Level, text string) string {
+		return newFormatter.ColorScheme().Colorize(logLevel, text)
+	})).Parse(text))
+	newFormatter.usesCaller = commonformatter.TemplateUsesField(newFormatter.plain, "Caller")
+	newFormatter.usesGoroutine = commonformatter.TemplateUsesField(newFormatter.plain, "Goroutine")
+	return newFormatter
+}
+
+// Template returns template string used by formatter.
+func (formatter *TemplateFormatter) Template() string {
+	return formatter.template
+}
+
+// UsesCaller returns true, if template references caller information of the
+// log record (.Caller) or passes the whole record, e.g. {{json .}}.
+func (formatter *TemplateFormatter) UsesCaller() bool {
+	return formatter.usesCaller
+}
+
+// UsesGoroutine returns true, if template references identifier of the
+// goroutine (.Goroutine) or passes the whole record, e.g. {{json .}}.
+func (formatter *TemplateFormatter) UsesGoroutine() bool {
+	return formatter.usesGoroutine
+}
+
+// Format executes template for the provided log record. If template could not
+// be executed, partial output is followed by the error marker (see
+// commonformatter.TemplateError).
+func (formatter *TemplateFormatter) Format(record logrecord.Interface, colored bool) string {
+	record = SanitizeRecord(record, formatter.SanitizeMode())
+	record = LimitRecord(record, formatter.Limits())
+
+	buffer := bufferPool.Get().(*bytes.Buffer)
+	buffer.Reset()
+	defer putBuffer(buffer)
+
+	data := commonformatter.NewTemplateRecord(record)
+	data.Message = record.Message()
+
+	parsed := formatter.plain
+	if colored {
+		parsed = formatter.colored
+	}
+
+	if err := parsed.Execute(buffer, data); err != nil {
+		buffer.WriteString(commonformatter.TemplateError(err))
+	}
+
+	fitBuffer(buffer, 0, formatter.Limits())
+
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -2,9 +2,12 @@
 package formatter
 
 import (
+	"bytes"
 	"fmt"
 	"github.com/dl1998/go-logging/internal/testutils"
+	commonformatter "github.com/dl1998/go-logging/pkg/common/formatter"
 	"github.com/dl1998/go-logging/pkg/common/level"
+	commonlogrecord "github.com/dl1998/go-logging/pkg/common/logrecord"
 	"github.com/dl1998/go-logging/pkg/logger/logrecord"
 	"strconv"
 	"strings"
@@ -28,6 +31,18 @@ func TestNew(t *testing.T) {
 	testutils.AssertEquals(t, template, newFormatter.template)
 }
 
+// TestNew_InvalidSpecifier tests that New panics if template contains invalid
+// format specifier.
+func TestNew_InvalidSpecifier(t *testing.T) {
+	defer func() {
+		if recovery := recover(); recovery == nil {
+			t.Fatalf("New did not panic on invalid format specifier")
+		}
+	}()
+
+	New("%(level:8z)")
+}
+
 // BenchmarkNew performs benchmarking of the New().
 func BenchmarkNew(b *testing.B) {
 	for index := 0; index < b.N; index++ {
@@ -72,6 +87,20 @@ func BenchmarkFormatter_Template(b *testing.B) {
 	}
 }
 
+// TestFormatter_UsesCaller tests that Formatter.UsesCaller reports whether
+// template uses caller information.
+func TestFormatter_UsesCaller(t *testing.T) {
+	testutils.AssertEquals(t, false, New(template).UsesCaller())
+	testutils.AssertEquals(t, true, New("%(fname):%(fline) %(message)").UsesCaller())
+}
+
+// TestFormatter_UsesGoroutine tests that Formatter.UsesGoroutine reports
+// whether template uses identifier of the goroutine.
+func TestFormatter_UsesGoroutine(t *testing.T) {
+	testutils.AssertEquals(t, false, New(template).UsesGoroutine())
+	testutils.AssertEquals(t, true, New("[%(goroutine)] %(message)").UsesGoroutine())
+}
+
 // TestFormatter_Format tests that Formatter.Format correctly formats string.
 func TestFormatter_Format(t *testing.T) {
 	newFormatter := New(template)
@@ -95,6 +124,45 @@ func TestFormatter_Format(t *testing.T) {
 	}
 }
 
+// TestFormatter_Format_MessageNotInterpreted tests that Formatter.Format does
+// not interpret placeholders from the message content.
+func TestFormatter_Format_MessageNotInterpreted(t *testing.T) {
+	newFormatter := New(template)
+
+	record := logrecord.New(loggerName, loggingLevel, timeFormat, "literal %s", []any{"%(level) and %(name)"}, skipCallers)
+
+	expected := fmt.Sprintf("%s:%s:literal %%(level) and %%(name)\n", loggingLevel.String(), loggerName)
+
+	testutils.AssertEquals(t, expected, newFormatter.Format(record, false))
+}
+
+// TestFormatter_Format_Fields tests that Formatter.Format interpolates fields
+// bound to the logger, missing fields are replaced with empty string.
+func TestFormatter_Format_Fields(t *testing.T) {
+	newFormatter := New("[%(field:request_id)] [%(field:user:>6s)] %(message)")
+
+	fields := map[string]interface{}{"request_id": "abc"}
+	record := logrecord.New(loggerName, loggingLevel, timeFormat, message, emptyParameters, skipCallers, commonlogrecord.WithFields(fields))
+
+	expected := fmt.Sprintf("[abc] [      ] %s\n", message)
+
+	testutils.AssertEquals(t, expected, newFormatter.Format(record, false))
+	testutils.AssertEquals(t, false, newFormatter.UsesCaller())
+}
+
+// TestFormatter_Format_CustomPlaceholder tests that Formatter.Format
+// interpolates registered custom placeholders.
+func TestFormatter_Format_CustomPlaceholder(t *testing.T) {
+	_ = commonformatter.RegisterStaticPlaceholder("test-service", "example")
+	defer commonformatter.UnregisterPlaceholder("test-service")
+
+	newFormatter := New("%(test-service:>8):%(message)")
+
+	record := logrecord.New(loggerName, loggingLevel, timeFormat, message, emptyParameters, skipCallers)
+
+	testutils.AssertEquals(t, fmt.Sprintf(" example:%s\n", message), newFormatter.Format(record, false))
+}
+
 // BenchmarkFormatter_Format performs benchmarking of the Formatter.Format().
 func BenchmarkFormatter_Format(b *testing.B) {
 	newFormatter := New(template)
@@ -117,6 +185,18 @@ func TestParseTemplate(t *testing.T) {
 	testutils.AssertEquals(t, expected, format)
 }
 
+// TestParseTemplate_Specifiers tests that ParseTemplate applies format
+// specifiers to the values.
+func TestParseTemplate_Specifiers(t *testing.T) {
+	record := logrecord.New(loggerName, loggingLevel, timeFormat, message, emptyParameters, skipCallers)
+
+	format := ParseTemplate("[%(level:-8s)] [%(name:>6)] %(fline:05d) %(message:.4) %(unknown)", record)
+
+	expected := fmt.Sprintf("[debug   ] [  test] %05d Test %%(unknown)", record.FileLine())
+
+	testutils.AssertEquals(t, expected, format)
+}
+
 // BenchmarkParseTemplate performs benchmarking of the ParseTemplate().
 func BenchmarkParseTemplate(b *testing.B) {
 	for index := 0; index < b.N; index++ {
@@ -124,6 +204,24 @@ func BenchmarkParseTemplate(b *testing.B) {
 	}
 }
 
+// BenchmarkReplaceKey_AllKeys performs benchmarking of the interpolation of
+// all keys using repeated ReplaceKey calls, it is used as a baseline for the
+// BenchmarkFormatter_Format.
+func BenchmarkReplaceKey_AllKeys(b *testing.B) {
+	record := logrecord.New(loggerName, loggingLevel, timeFormat, message, emptyParameters, skipCallers)
+
+	keys := []string{"%(name)", "%(level)", "%(levelnr)", "%(datetime)", "%(timestamp)", "%(fname)", "%(fline)", "%(message)"}
+
+	b.ResetTimer()
+
+	for index := 0; index < b.N; index++ {
+		format := template
+		for _, key := range keys {
+			format = ReplaceKey(format, key, record)
+		}
+	}
+}
+
 // TestReplaceKey tests that ReplaceKey correctly replaces key with value.
 func TestReplaceKey(t *testing.T) {
 	record := logrecord.New(loggerName, loggingLevel, timeFormat, message, emptyParameters, skipCallers)
@@ -221,3 +319,288 @@ func BenchmarkParseKey(b *testing.B) {
 		})
 	}
 }
+
+// TestNewTemplate tests that NewTemplate create correct TemplateFormatter
+// instance.
+func TestNewTemplate(t *testing.T) {
+	newFormatter := NewTemplate("{{.Message}}")
+
+	testutils.AssertEquals(t, "{{.Message}}", newFormatter.Template())
+	testutils.AssertNotNil(t, newFormatter.plain)
+	testutils.AssertNotNil(t, newFormatter.colored)
+}
+
+// TestNewTemplate_Error tests that NewTemplate panics if template could not be
+// parsed.
+func TestNewTemplate_Error(t *testing.T) {
+	defer func() {
+		if recovery := recover(); recovery == nil {
+			t.Fatalf("NewTemplate did not panic on invalid template")
+		}
+	}()
+
+	NewTemplate("{{.Message")
+}
+
+// BenchmarkNewTemplate performs benchmarking of the NewTemplate().
+func BenchmarkNewTemplate(b *testing.B) {
+	for index := 0; index < b.N; index++ {
+		NewTemplate("{{.Message}}")
+	}
+}
+
+// TestTemplateFormatter_UsesCaller tests that TemplateFormatter.UsesCaller
+// reports whether template references caller information.
+func TestTemplateFormatter_UsesCaller(t *testing.T) {
+	testutils.AssertEquals(t, false, NewTemplate("{{.Message}}").UsesCaller())
+	testutils.AssertEquals(t, true, NewTemplate("{{.Caller.File}}: {{.Message}}").UsesCaller())
+	testutils.AssertEquals(t, true, NewTemplate("{{json .}}").UsesCaller())
+}
+
+// TestTemplateFormatter_UsesGoroutine tests that
+// TemplateFormatter.UsesGoroutine reports whether template references
+// identifier of the goroutine.
+func TestTemplateFormatter_UsesGoroutine(t *testing.T) {
+	testutils.AssertEquals(t, false, NewTemplate("{{.Message}}").UsesGoroutine())
+	testutils.AssertEquals(t, true, NewTemplate("[{{.Goroutine}}] {{.Message}}").UsesGoroutine())
+	testutils.AssertEquals(t, true, NewTemplate("{{json .}}").UsesGoroutine())
+}
+
+// TestTemplateFormatter_Format tests that TemplateFormatter.Format correctly
+// executes template.
+func TestTemplateFormatter_Format(t *testing.T) {
+	text := `{{color .Level (upper .Level | pad 5)}} {{.Name}}: {{.Message}}{{if ge .Level (level "error")}} at {{.Caller.File}}{{end}}`
+
+	newFormatter := NewTemplate(text)
+
+	tests := map[string]struct {
+		level    level.Level
+		colored  bool
+		expected string
+	}{
+		"Not Colored": {
+			level:    level.Debug,
+			colored:  false,
+			expected: fmt.Sprintf("DEBUG %s: %s\n", loggerName, message),
+		},
+		"Colored": {
+			level:    level.Debug,
+			colored:  true,
+			expected: fmt.Sprintf("\033[36mDEBUG\033[0m %s: %s\n", loggerName, message),
+		},
+		"Conditional Caller": {
+			level:    level.Error,
+			colored:  false,
+			expected: fmt.Sprintf("ERROR %s: %s at %%s\n", loggerName, message),
+		},
+	}
+
+	for name, parameters := range tests {
+		record := logrecord.New(loggerName, parameters.level, timeFormat, message, emptyParameters, skipCallers)
+
+		t.Run(name, func(t *testing.T) {
+			expected := parameters.expected
+			if strings.Contains(expected, "%s") {
+				expected = fmt.Sprintf(expected, record.FileName())
+			}
+
+			testutils.AssertEquals(t, expected, newFormatter.Format(record, parameters.colored))
+		})
+	}
+}
+
+// TestTemplateFormatter_Format_Fields tests that TemplateFormatter.Format
+// provides fields bound to the logger.
+func TestTemplateFormatter_Format_Fields(t *testing.T) {
+	newFormatter := NewTemplate(`{{.Fields.request_id}} {{.Message}}`)
+
+	fields := map[string]interface{}{"request_id": "abc"}
+	record := logrecord.New(loggerName, loggingLevel, timeFormat, message, emptyParameters, skipCallers, commonlogrecord.WithFields(fields))
+
+	testutils.AssertEquals(t, fmt.Sprintf("abc %s\n", message), newFormatter.Format(record, false))
+}
+
+// TestTemplateFormatter_Format_Error tests that TemplateFormatter.Format
+// returns partial output followed by the error marker, if template could not be
+// executed.
+func TestTemplateFormatter_Format_Error(t *testing.T) {
+	newFormatter := NewTemplate("{{.Message}} {{.Unknown}}")
+
+	record := logrecord.New(loggerName, loggingLevel, timeFormat, message, emptyParameters, skipCallers)
+
+	output := newFormatter.Format(record, false)
+
+	testutils.AssertEquals(t, true, strings.HasPrefix(output, message+" %!(TEMPLATE ERROR: "))
+	testutils.AssertEquals(t, true, strings.Contains(output, "Unknown"))
+	testutils.AssertEquals(t, true, strings.HasSuffix(output, ")\n"))
+}
+
+// BenchmarkTemplateFormatter_Format performs benchmarking of the
+// TemplateFormatter.Format().
+func BenchmarkTemplateFormatter_Format(b *testing.B) {
+	newFormatter := NewTemplate("{{upper .Level}} {{.Name}}: {{.Message}}")
+
+	record := logrecord.New(loggerName, loggingLevel, timeFormat, message, emptyParameters, skipCallers)
+
+	b.ResetTimer()
+
+	for index := 0; index < b.N; index++ {
+		newFormatter.Format(record, true)
+	}
+}
+
+// TestSanitizeRecord tests that SanitizeRecord sanitizes message of the log
+// record and returns the same record, if sanitization is not needed.
+func TestSanitizeRecord(t *testing.T) {
+	forged := logrecord.New(loggerName, loggingLevel, timeFormat, "a\nerror:forged", emptyParameters, skipCallers)
+	clean := logrecord.New(loggerName, loggingLevel, timeFormat, message, emptyParameters, skipCallers)
+
+	testutils.AssertEquals(t, `a\nerror:forged`, SanitizeRecord(forged, commonformatter.SanitizeEscape).Message())
+	testutils.AssertEquals(t, logrecord.Interface(forged), SanitizeRecord(forged, commonformatter.SanitizeNone))
+	testutils.AssertEquals(t, logrecord.Interface(clean), SanitizeRecord(clean, commonformatter.SanitizeEscape))
+}
+
+// BenchmarkSanitizeRecord benchmarks the SanitizeRecord function.
+func BenchmarkSanitizeRecord(b *testing.B) {
+	record := logrecord.New(loggerName, loggingLevel, timeFormat, message, emptyParameters, skipCallers)
+
+	for index := 0; index < b.N; index++ {
+		SanitizeRecord(record, commonformatter.SanitizeEscape)
+	}
+}
+
+// TestFormatter_Format_Sanitize tests that Formatter.Format sanitizes message
+// using its sanitization mode.
+func TestFormatter_Format_Sanitize(t *testing.T) {
+	newFormatter := New(template)
+	newFormatter.SetSanitizeMode(commonformatter.SanitizeStrip)
+
+	record := logrecord.New(loggerName, loggingLevel, timeFormat, "\x1b[31mred\x1b[0m\n", emptyParameters, skipCallers)
+
+	testutils.AssertEquals(t, "debug:test:red\n", newFormatter.Format(record, false))
+}
+
+// TestTemplateFormatter_Format_Sanitize tests that TemplateFormatter.Format
+// sanitizes message using its sanitization mode.
+func TestTemplateFormatter_Format_Sanitize(t *testing.T) {
+	newFormatter := NewTemplate("{{.Message}}")
+	newFormatter.SetSanitizeMode(commonformatter.SanitizeReplace)
+
+	record := logrecord.New(loggerName, loggingLevel, timeFormat, "a\nb", emptyParameters, skipCallers)
+
+	testutils.AssertEquals(t, "a b\n", newFormatter.Format(record, false))
+}
+
+// TestLimitRecord tests that LimitRecord cuts message of the log record and
+// returns the same record, if message does not exceed limit.
+func TestLimitRecord(t *testing.T) {
+	record := logrecord.New(loggerName, loggingLevel, timeFormat, "abcdef", emptyParameters, skipCallers)
+
+	testutils.AssertEquals(t, "abc…(truncated 3 bytes)", LimitRecord(record, commonformatter.Limits{MaxValueLength: 3}).Message())
+	testutils.AssertEquals(t, logrecord.Interface(record), LimitRecord(record, commonformatter.Limits{MaxValueLength: 6}))
+	testutils.AssertEquals(t, logrecord.Interface(record), LimitRecord(record, commonformatter.Limits{}))
+}
+
+// BenchmarkLimitRecord benchmarks the LimitRecord function.
+func BenchmarkLimitRecord(b *testing.B) {
+	record := logrecord.New(loggerName, loggingLevel, timeFormat, message, emptyParameters, skipCallers)
+	limits := commonformatter.Limits{MaxValueLength: 2}
+
+	for index := 0; index < b.N; index++ {
+		LimitRecord(record, limits)
+	}
+}
+
+// TestFormatter_Format_Limits tests that Formatter.Format cuts message and
+// formatted log record according to the limits.
+func TestFormatter_Format_Limits(t *testing.T) {
+	newFormatter := New(template)
+	newFormatter.SetLimits(commonformatter.Limits{MaxValueLength: 4})
+
+	record := logrecord.New(loggerName, loggingLevel, timeFormat, "message", emptyParameters, skipCallers)
+
+	testutils.AssertEquals(t, "debug:test:mess…(truncated 3 bytes)\n", newFormatter.Format(record, false))
+
+	newFormatter.SetLimits(commonformatter.Limits{MaxRecordSize: 30})
+
+	record = logrecord.New(loggerName, loggingLevel, timeFormat, strings.Repeat("a", 100), emptyParameters, skipCallers)
+
+	testutils.AssertEquals(t, "debug:…(truncated 105 bytes)\n", newFormatter.Format(record, false))
+}
+
+// TestTemplateFormatter_Format_Limits tests that TemplateFormatter.Format cuts
+// message and formatted log record according to the limits.
+func TestTemplateFormatter_Format_Limits(t *testing.T) {
+	newFormatter := NewTemplate("{{.Message}}")
+	newFormatter.SetLimits(commonformatter.Limits{MaxValueLength: 2})
+
+	record := logrecord.New(loggerName, loggingLevel, timeFormat, "abcdef", emptyParameters, skipCallers)
+
+	testutils.AssertEquals(t, "ab…(truncated 4 bytes)\n", newFormatter.Format(record, false))
+
+	newFormatter.SetLimits(commonformatter.Limits{MaxRecordSize: 24})
+
+	record = logrecord.New(loggerName, loggingLevel, timeFormat, strings.Repeat("a", 30), emptyParameters, skipCallers)
+
+	testutils.AssertEquals(t, "a…(truncated 29 bytes)\n", newFormatter.Format(record, false))
+}
+
+// TestFormatter_Format_ColorScheme tests that Formatter.Format colors only the
+// part of the log record defined by the mode of the color scheme.
+func TestFormatter_Format_ColorScheme(t *testing.T) {
+	tests := map[string]struct {
+		mode     commonformatter.ColorMode
+		expected string
+	}{
+		"Line":    {commonformatter.ColorLine, fmt.Sprintf("\033[35m%s:%s:%s\033[0m\n", loggingLevel.String(), loggerName, message)},
+		"Level":   {commonformatter.ColorLevel, fmt.Sprintf("\033[35m%s\033[0m:%s:%s\n", loggingLevel.String(), loggerName, message)},
+		"Message": {commonformatter.ColorMessage, fmt.Sprintf("%s:%s:\033[35m%s\033[0m\n", loggingLevel.String(), loggerName, message)},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			scheme := commonformatter.DefaultColorScheme()
+			scheme.Levels[loggingLevel] = "\033[35m"
+			scheme.Mode = test.mode
+
+			newFormatter := New(template)
+			newFormatter.SetColorScheme(scheme)
+
+			record := logrecord.New(loggerName, loggingLevel, timeFormat, message, emptyParameters, skipCallers)
+
+			testutils.AssertEquals(t, test.expected, newFormatter.Format(record, true))
+		})
+	}
+}
+
+// TestTemplateFormatter_Format_ColorScheme tests that "color" helper of the
+// TemplateFormatter uses color scheme of the formatter.
+func TestTemplateFormatter_Format_ColorScheme(t *testing.T) {
+	newFormatter := NewTemplate("{{color .Level .Message}}")
+	newFormatter.SetColorScheme(&commonformatter.ColorScheme{Levels: map[level.Level]commonformatter.Color{loggingLevel: "\033[35m"}})
+
+	record := logrecord.New(loggerName, loggingLevel, timeFormat, message, emptyParameters, skipCallers)
+
+	testutils.AssertEquals(t, fmt.Sprintf("\033[35m%s\033[0m\n", message), newFormatter.Format(record, true))
+}
+
+// TestPutBuffer tests that putBuffer drops buffers larger than
+// maxPooledBufferSize.
+func TestPutBuffer(t *testing.T) {
+	large := bytes.NewBuffer(make([]byte, 0, maxPooledBufferSize+1))
+
+	putBuffer(large)
+
+	for index := 0; index < 10; index++ {
+		if bufferPool.Get().(*bytes.Buffer) == large {
+			t.Fatalf("buffer larger than %d bytes has been returned to the pool", maxPooledBufferSize)
+		}
+	}
+}
+
+// BenchmarkPutBuffer performs benchmarking of the putBuffer().
+func BenchmarkPutBuffer(b *testing.B) {
+	for index := 0; index < b.N; index++ {
+		putBuffer(bufferPool.Get().(*bytes.Buffer))
+	}
+}
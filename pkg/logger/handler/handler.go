@@ -4,6 +4,7 @@ package handler
 
 import (
 	"fmt"
+	commonformatter "github.com/dl1998/go-logging/pkg/common/formatter"
 	"github.com/dl1998/go-logging/pkg/common/handler"
 	"github.com/dl1998/go-logging/pkg/common/level"
 	"github.com/dl1998/go-logging/pkg/logger/formatter"
@@ -55,7 +56,8 @@ func NewConsoleErrorHandler(fromLevel level.Level, toLevel level.Level, newForma
 }
 
 // NewFileHandler creates a new instance of the Handler that writes log message
-// to the log file.
+// to the log file. Control characters in the messages are escaped, unless
+// formatter defines its own sanitization mode. Colors are disabled.
 func NewFileHandler(fromLevel level.Level, toLevel level.Level, newFormatter formatter.Interface, file string) *Handler {
 	writer, err := osOpenFile(file, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
 
@@ -64,7 +66,11 @@ func NewFileHandler(fromLevel level.Level, toLevel level.Level, newFormatter for
 		return nil
 	}
 
-	return New(fromLevel, toLevel, newFormatter, writer)
+	newHandler := New(fromLevel, toLevel, newFormatter, writer)
+	newHandler.SetSanitizeMode(commonformatter.SanitizeEscape)
+	newHandler.SetColorOutput(handler.ColorNever)
+
+	return newHandler
 }
 
 // Formatter returns formatter of the Handler.
@@ -78,10 +84,10 @@ func (handler *Handler) Write(record logrecord.Interface) {
 		return
 	}
 
-	var colored = false
+	colored := handler.Colored()
 
-	if handler.ConsoleSupportsANSIColors() && (handler.Writer() == osStdout || handler.Writer() == osStderr) {
-		colored = true
+	if mode := handler.SanitizeMode(); mode > commonformatter.SanitizeNone && commonformatter.SanitizeModeOf(handler.formatter) == commonformatter.SanitizeDefault {
+		record = formatter.SanitizeRecord(record, mode)
 	}
 
 	log := handler.formatter.Format(record, colored)
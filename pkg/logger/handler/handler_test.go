@@ -5,6 +5,8 @@ import (
 	"bytes"
 	"fmt"
 	"github.com/dl1998/go-logging/internal/testutils"
+	commonformatter "github.com/dl1998/go-logging/pkg/common/formatter"
+	commonhandler "github.com/dl1998/go-logging/pkg/common/handler"
 	"github.com/dl1998/go-logging/pkg/common/level"
 	"github.com/dl1998/go-logging/pkg/logger/formatter"
 	"github.com/dl1998/go-logging/pkg/logger/logrecord"
@@ -118,6 +120,8 @@ func TestNewFileHandler(t *testing.T) {
 
 	testutils.AssertEquals(t, fromLevel, newHandler.FromLevel())
 	testutils.AssertEquals(t, toLevel, newHandler.ToLevel())
+	testutils.AssertEquals(t, commonformatter.SanitizeEscape, newHandler.SanitizeMode())
+	testutils.AssertEquals(t, commonhandler.ColorNever, newHandler.ColorOutput())
 
 	if newHandler.Writer() != os.Stdout {
 		t.Fatalf("writer is not the same. expected: %v, actual: %v", os.Stdout, newHandler.Writer())
@@ -253,3 +257,34 @@ func BenchmarkHandler_Write(b *testing.B) {
 		newHandler.Write(record)
 	}
 }
+
+// TestHandler_Write_Sanitize tests that Handler.Write sanitizes message using
+// mode of the handler, unless formatter defines its own mode.
+func TestHandler_Write_Sanitize(t *testing.T) {
+	tests := map[string]struct {
+		formatterMode commonformatter.SanitizeMode
+		handlerMode   commonformatter.SanitizeMode
+		expected      string
+	}{
+		"Handler Mode":   {formatterMode: commonformatter.SanitizeDefault, handlerMode: commonformatter.SanitizeEscape, expected: "debug:test:a\\nb\n"},
+		"Formatter Mode": {formatterMode: commonformatter.SanitizeStrip, handlerMode: commonformatter.SanitizeEscape, expected: "debug:test:ab\n"},
+		"Disabled":       {formatterMode: commonformatter.SanitizeNone, handlerMode: commonformatter.SanitizeEscape, expected: "debug:test:a\nb\n"},
+		"Without Mode":   {formatterMode: commonformatter.SanitizeDefault, handlerMode: commonformatter.SanitizeDefault, expected: "debug:test:a\nb\n"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			buffer := &bytes.Buffer{}
+
+			newFormatter := formatter.New(template)
+			newFormatter.SetSanitizeMode(test.formatterMode)
+
+			newHandler := New(level.Debug, toLevel, newFormatter, buffer)
+			newHandler.SetSanitizeMode(test.handlerMode)
+
+			newHandler.Write(logrecord.New(loggerName, level.Debug, "", "a\nb", emptyParameters, 1))
+
+			testutils.AssertEquals(t, test.expected, buffer.String())
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package handler
+
+import (
+	commonhandler "github.com/dl1998/go-logging/pkg/common/handler"
+	"github.com/dl1998/go-logging/pkg/common/level"
+	"github.com/dl1998/go-logging/pkg/logger/formatter"
+	"github.com/dl1998/go-logging/pkg/logger/logrecord"
+	"log/slog"
+)
+
+// SlogHandler struct passes log records to the slog.Handler, so existing
+// slog.Handler implementations (e.g. slog.NewJSONHandler) could be used as
+// handlers of the logger.
+type SlogHandler struct {
+	*commonhandler.Handler
+	slogHandler slog.Handler
+}
+
+// NewSlogHandler creates a new instance of the SlogHandler that passes log
+// records between fromLevel and toLevel to the slog.Handler.
+func NewSlogHandler(fromLevel level.Level, toLevel level.Level, slogHandler slog.Handler) *SlogHandler {
+	return &SlogHandler{
+		Handler:     commonhandler.New(fromLevel, toLevel, nil),
+		slogHandler: slogHandler,
+	}
+}
+
+// SlogHandler returns slog.Handler wrapped by the SlogHandler.
+func (handler *SlogHandler) SlogHandler() slog.Handler {
+	return handler.slogHandler
+}
+
+// Formatter returns nil, log records are formatted by the slog.Handler.
+func (handler *SlogHandler) Formatter() formatter.Interface {
+	return nil
+}
+
+// Write converts log record into slog.Record and passes it to the
+// slog.Handler. Contextual fields bound to the logger become attributes of the
+// slog.Record.
+func (handler *SlogHandler) Write(record logrecord.Interface) {
+	if record.Level().DigitRepresentation() < handler.FromLevel().DigitRepresentation() || record.Level().DigitRepresentation() > handler.ToLevel().DigitRepresentation() {
+		return
+	}
+
+	commonhandler.HandleSlog(handler.slogHandler, commonhandler.NewSlogRecord(record, record.Message(), record.Fields()))
+}
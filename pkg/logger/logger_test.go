@@ -2,12 +2,23 @@
 package logger
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"github.com/dl1998/go-logging/internal/testutils"
+	"github.com/dl1998/go-logging/pkg/common/lazy"
 	"github.com/dl1998/go-logging/pkg/common/level"
+	"github.com/dl1998/go-logging/pkg/common/logcontext"
+	"github.com/dl1998/go-logging/pkg/common/redaction"
+	"github.com/dl1998/go-logging/pkg/common/tracing"
+	"github.com/dl1998/go-logging/pkg/logger/formatter"
 	"github.com/dl1998/go-logging/pkg/logger/handler"
+	"io"
 	"net/http"
 	"net/url"
+	"runtime"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -194,6 +205,349 @@ func testLoggerLogMethod(t *testing.T, logLevel level.Level, logMethod func(test
 	assertLog(t, mockLogger, logLevel)
 }
 
+// TestLogger_SetLocation tests that Logger.SetLocation sets location in the
+// baseLogger and Logger.Location returns it.
+func TestLogger_SetLocation(t *testing.T) {
+	mockLogger, newLogger := createMockedLogger()
+
+	newLogger.SetLocation(time.UTC)
+
+	testutils.AssertEquals(t, "SetLocation", mockLogger.CalledName)
+	testutils.AssertEquals(t, time.UTC, newLogger.Location())
+}
+
+// BenchmarkLogger_SetLocation perform benchmarking of the
+// Logger.SetLocation().
+func BenchmarkLogger_SetLocation(b *testing.B) {
+	_, newLogger := createMockedLogger()
+
+	for index := 0; index < b.N; index++ {
+		newLogger.SetLocation(time.UTC)
+	}
+}
+
+// TestLogger_SetStackLevel tests that Logger.SetStackLevel sets stack level in
+// the baseLogger and Logger.StackLevel returns it.
+func TestLogger_SetStackLevel(t *testing.T) {
+	mockLogger, newLogger := createMockedLogger()
+
+	newLogger.SetStackLevel(level.Error)
+
+	testutils.AssertEquals(t, "SetStackLevel", mockLogger.CalledName)
+	testutils.AssertEquals(t, level.Error, newLogger.StackLevel())
+}
+
+// TestLogger_SetRedactor tests that Logger.SetRedactor sets a new redactor for
+// the Logger.
+func TestLogger_SetRedactor(t *testing.T) {
+	mockLogger, newLogger := createMockedLogger()
+
+	redactor := redaction.New("")
+
+	newLogger.SetRedactor(redactor)
+
+	testutils.AssertEquals(t, "SetRedactor", mockLogger.CalledName)
+	testutils.AssertEquals(t, redactor, newLogger.Redactor())
+}
+
+// TestLogger_SetLevel tests that Logger.SetLevel sets minimum level of the log
+// records in the base logger.
+func TestLogger_SetLevel(t *testing.T) {
+	mockLogger, newLogger := createMockedLogger()
+
+	newLogger.SetLevel(level.Info)
+
+	testutils.AssertEquals(t, "SetLevel", mockLogger.CalledName)
+	testutils.AssertEquals(t, level.Info, newLogger.Level())
+	testutils.AssertEquals(t, level.Info, newLogger.EffectiveLevel())
+}
+
+// BenchmarkLogger_SetLevel perform benchmarking of the Logger.SetLevel().
+func BenchmarkLogger_SetLevel(b *testing.B) {
+	_, newLogger := createMockedLogger()
+
+	for index := 0; index < b.N; index++ {
+		newLogger.SetLevel(level.Info)
+	}
+}
+
+// TestLogger_Enabled tests that Logger.Enabled returns result of the base
+// logger.
+func TestLogger_Enabled(t *testing.T) {
+	mockLogger, newLogger := createMockedLogger()
+
+	newLogger.SetLevel(level.Info)
+
+	testutils.AssertEquals(t, false, newLogger.Enabled(level.Debug))
+	testutils.AssertEquals(t, "Enabled", mockLogger.CalledName)
+	testutils.AssertEquals(t, true, newLogger.Enabled(level.Info))
+}
+
+// TestLogger_Enabled_Allocations tests that logging with disabled level does
+// not allocate memory.
+func TestLogger_Enabled_Allocations(t *testing.T) {
+	newLogger := New(loggerName, timeFormat)
+	newLogger.AddHandler(handler.New(level.Info, level.Null, formatter.New("%(message)"), io.Discard))
+
+	derived := newLogger.With("request_id", "abc")
+
+	testutils.AssertEquals(t, false, newLogger.Enabled(level.Debug))
+	testutils.AssertEquals(t, 0.0, testing.AllocsPerRun(100, func() {
+		newLogger.Debug(message, parameters...)
+	}))
+	testutils.AssertEquals(t, 0.0, testing.AllocsPerRun(100, func() {
+		derived.Debug(message, parameters...)
+	}))
+}
+
+// BenchmarkLogger_Disabled perform benchmarking of the logging with disabled
+// level.
+func BenchmarkLogger_Disabled(b *testing.B) {
+	newLogger := New(loggerName, timeFormat)
+	newLogger.AddHandler(handler.New(level.Info, level.Null, formatter.New("%(message)"), io.Discard))
+
+	b.ReportAllocs()
+
+	for index := 0; index < b.N; index++ {
+		newLogger.Debug(message, parameters...)
+	}
+}
+
+// TestLogger_Lazy tests that lazy values are evaluated only if log record is
+// accepted by the handler.
+func TestLogger_Lazy(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	newLogger := New(loggerName, timeFormat)
+	newLogger.AddHandler(handler.New(level.Info, level.Null, formatter.New("%(field:state) %(message)"), buffer))
+
+	evaluations := 0
+	state := lazy.Value(func() any {
+		evaluations++
+		return "computed"
+	})
+
+	newLogger.With("state", state).Debug("state %s", state)
+
+	testutils.AssertEquals(t, 0, evaluations)
+
+	newLogger.With("state", state).Info("state %s", state)
+
+	testutils.AssertEquals(t, 2, evaluations)
+	testutils.AssertEquals(t, "computed state computed\n", buffer.String())
+}
+
+// BenchmarkLogger_Lazy perform benchmarking of the logging of the lazy values
+// with disabled level.
+func BenchmarkLogger_Lazy(b *testing.B) {
+	newLogger := New(loggerName, timeFormat)
+	newLogger.AddHandler(handler.New(level.Info, level.Null, formatter.New("%(field:state) %(message)"), io.Discard))
+
+	state := lazy.Value(func() any { return "computed" })
+
+	b.ReportAllocs()
+
+	for index := 0; index < b.N; index++ {
+		newLogger.Debug("state %s", state)
+	}
+}
+
+// TestLogger_SetPropagate tests that Logger.SetPropagate sets propagation in
+// the base logger.
+func TestLogger_SetPropagate(t *testing.T) {
+	mockLogger, newLogger := createMockedLogger()
+
+	newLogger.SetPropagate(true)
+
+	testutils.AssertEquals(t, "SetPropagate", mockLogger.CalledName)
+	testutils.AssertEquals(t, true, newLogger.Propagate())
+}
+
+// TestLogger_WrapRequest_Redaction tests that Logger.WrapRequest applies
+// redaction rules to the fields of the request.
+func TestLogger_WrapRequest_Redaction(t *testing.T) {
+	mockLogger, newLogger := createMockedLogger()
+	newLogger.SetRedactor(redaction.New("", redaction.Rule{Key: "Header.Authorization"}))
+	newLogger.SetRequestTemplate("{Method} {Header.Authorization}")
+
+	request := &http.Request{Method: http.MethodGet, Header: http.Header{"Authorization": {"Bearer token"}}}
+
+	newLogger.WrapRequest(logLevel, request)
+
+	testutils.AssertEquals(t, "GET ***", mockLogger.Parameters[2])
+}
+
+// TestLogger_With tests that Logger.With returns a derived Logger that passes
+// bound fields to the base logger and keeps the Logger unchanged.
+func TestLogger_With(t *testing.T) {
+	mockLogger, newLogger := createMockedLogger()
+
+	derived := newLogger.With("request_id", "abc", "user", "john")
+	derived.Info(message, parameters...)
+
+	expected := map[string]interface{}{"request_id": "abc", "user": "john"}
+
+	testutils.AssertEquals(t, "LogFields", mockLogger.CalledName)
+	testutils.AssertEquals(t, []any{level.Info, skipCallers + 1, expected, message, parameters[0]}, mockLogger.Parameters)
+
+	newLogger.Info(message, parameters...)
+
+	testutils.AssertEquals(t, "Log", mockLogger.CalledName)
+}
+
+// BenchmarkLogger_With perform benchmarking of the Logger.With().
+func BenchmarkLogger_With(b *testing.B) {
+	_, newLogger := createMockedLogger()
+
+	for index := 0; index < b.N; index++ {
+		newLogger.With("request_id", "abc")
+	}
+}
+
+// TestLogger_WithFields tests that Logger.WithFields returns a derived Logger
+// that shares handlers and writes bound fields referenced by the template.
+func TestLogger_WithFields(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	newLogger := New(loggerName, timeFormat)
+	newLogger.AddHandler(handler.New(level.All, level.Null, formatter.New("%(field:request_id) %(field:user:-5s)|%(fline) %(message)"), buffer))
+
+	fields := map[string]interface{}{"request_id": "abc"}
+	derived := newLogger.WithFields(fields).With("user", "john")
+	fields["request_id"] = "changed"
+
+	_, _, line, _ := runtime.Caller(0)
+	derived.Info("first")
+	newLogger.Info("second")
+
+	expected := fmt.Sprintf("abc john |%d first\n      |%d second\n", line+1, line+2)
+
+	testutils.AssertEquals(t, expected, buffer.String())
+	testutils.AssertEquals(t, newLogger.Handlers(), derived.Handlers())
+}
+
+// syncBuffer is a bytes.Buffer safe for concurrent writes.
+type syncBuffer struct {
+	mutex  sync.Mutex
+	buffer bytes.Buffer
+}
+
+// Write writes data into the buffer.
+func (buffer *syncBuffer) Write(data []byte) (int, error) {
+	buffer.mutex.Lock()
+	defer buffer.mutex.Unlock()
+	return buffer.buffer.Write(data)
+}
+
+// String returns content of the buffer.
+func (buffer *syncBuffer) String() string {
+	buffer.mutex.Lock()
+	defer buffer.mutex.Unlock()
+	return buffer.buffer.String()
+}
+
+// TestLogger_With_Concurrent tests that derived loggers could be created and
+// used concurrently.
+func TestLogger_With_Concurrent(t *testing.T) {
+	buffer := &syncBuffer{}
+
+	newLogger := New(loggerName, timeFormat)
+	newLogger.AddHandler(handler.New(level.All, level.Null, formatter.New("%(field:request_id)"), buffer))
+
+	var waitGroup sync.WaitGroup
+	for index := 0; index < 10; index++ {
+		waitGroup.Add(1)
+		go func(index int) {
+			defer waitGroup.Done()
+			newLogger.With("request_id", index).Debug(message, parameters...)
+		}(index)
+	}
+	waitGroup.Wait()
+
+	testutils.AssertEquals(t, 10, strings.Count(buffer.String(), "\n"))
+}
+
+// requestKey is a key of the request identifier stored in the context.
+type requestKey struct{}
+
+// TestLogger_Ctx tests that Logger.Ctx returns a derived Logger with fields
+// extracted from the context.
+func TestLogger_Ctx(t *testing.T) {
+	defer logcontext.UnregisterExtractor("test-request")
+
+	_ = logcontext.RegisterValueExtractor("test-request", "request_id", requestKey{})
+
+	mockLogger, newLogger := createMockedLogger()
+
+	ctx := context.WithValue(context.Background(), requestKey{}, "abc")
+
+	derived := newLogger.Ctx(ctx)
+	derived.Info(message, parameters...)
+
+	testutils.AssertEquals(t, "LogFields", mockLogger.CalledName)
+	testutils.AssertEquals(t, any(map[string]interface{}{"request_id": "abc"}), mockLogger.Parameters[2])
+	testutils.AssertEquals(t, newLogger, newLogger.Ctx(context.Background()))
+}
+
+// spanKey is a key of the fake span context stored in the context.
+type spanKey struct{}
+
+// TestLogger_Ctx_Tracing tests that Logger.Ctx adds identifiers of the span
+// extracted by the registered tracing extractor.
+func TestLogger_Ctx_Tracing(t *testing.T) {
+	defer tracing.Unregister()
+
+	_ = tracing.Register(tracing.ExtractorFunc(func(ctx context.Context) (tracing.SpanContext, bool) {
+		spanContext, ok := ctx.Value(spanKey{}).(tracing.SpanContext)
+		return spanContext, ok
+	}))
+
+	ctx := context.WithValue(context.Background(), spanKey{}, tracing.SpanContext{
+		TraceID:    "4bf92f3577b34da6a3ce929d0e0e4736",
+		SpanID:     "00f067aa0ba902b7",
+		TraceFlags: "01",
+	})
+
+	buffer := &bytes.Buffer{}
+
+	newLogger := New(loggerName, timeFormat)
+	newLogger.AddHandler(handler.New(level.All, level.Null, formatter.New("%(trace_id) %(span_id) %(trace_flags) %(message)"), buffer))
+
+	newLogger.Ctx(ctx).Info("traced")
+	newLogger.Info("not traced")
+
+	expected := "4bf92f3577b34da6a3ce929d0e0e4736 00f067aa0ba902b7 01 traced\n   not traced\n"
+
+	testutils.AssertEquals(t, expected, buffer.String())
+}
+
+// BenchmarkLogger_Ctx perform benchmarking of the Logger.Ctx().
+func BenchmarkLogger_Ctx(b *testing.B) {
+	defer logcontext.UnregisterExtractor("test-request")
+
+	_ = logcontext.RegisterValueExtractor("test-request", "request_id", requestKey{})
+
+	_, newLogger := createMockedLogger()
+
+	ctx := context.WithValue(context.Background(), requestKey{}, "abc")
+
+	b.ResetTimer()
+
+	for index := 0; index < b.N; index++ {
+		newLogger.Ctx(ctx)
+	}
+}
+
+// BenchmarkLogger_SetStackLevel perform benchmarking of the
+// Logger.SetStackLevel().
+func BenchmarkLogger_SetStackLevel(b *testing.B) {
+	_, newLogger := createMockedLogger()
+
+	for index := 0; index < b.N; index++ {
+		newLogger.SetStackLevel(level.Error)
+	}
+}
+
 // TestLogger_Trace tests that Logger.Trace logs message with parameters on trace
 // level.
 func TestLogger_Trace(t *testing.T) {
@@ -497,6 +851,22 @@ func BenchmarkLogger_SetPanicLevel(b *testing.B) {
 	}
 }
 
+// TestLogger_RaiseError_Stack tests that Logger.RaiseError attaches stack of
+// the goroutine starting from the caller of the logger.
+func TestLogger_RaiseError_Stack(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	newLogger := New(loggerName, timeFormat)
+	newLogger.SetStackLevel(level.Error)
+	newLogger.AddHandler(handler.New(level.All, level.Null, formatter.New("%(stack)"), buffer))
+
+	_ = newLogger.RaiseError(message, parameters...)
+
+	expected := "github.com/dl1998/go-logging/pkg/logger.TestLogger_RaiseError_Stack\n"
+
+	testutils.AssertEquals(t, true, strings.HasPrefix(buffer.String(), expected))
+}
+
 // TestLogger_RaiseError tests that Logger.RaiseError logs message with
 // parameters on error level and returns a new error.
 func TestLogger_RaiseError(t *testing.T) {
@@ -1011,6 +1381,51 @@ func BenchmarkWithTimeFormat(b *testing.B) {
 	}
 }
 
+// TestWithLocation tests that WithLocation sets location in the Configuration.
+func TestWithLocation(t *testing.T) {
+	configuration := NewConfiguration()
+
+	option := WithLocation(time.UTC)
+
+	option(configuration)
+
+	testutils.AssertEquals(t, time.UTC, configuration.location)
+}
+
+// BenchmarkWithLocation perform benchmarking of the WithLocation().
+func BenchmarkWithLocation(b *testing.B) {
+	configuration := NewConfiguration()
+
+	option := WithLocation(time.UTC)
+
+	for index := 0; index < b.N; index++ {
+		option(configuration)
+	}
+}
+
+// TestWithStackLevel tests that WithStackLevel sets stack level in the
+// Configuration.
+func TestWithStackLevel(t *testing.T) {
+	configuration := NewConfiguration()
+
+	option := WithStackLevel(level.Error)
+
+	option(configuration)
+
+	testutils.AssertEquals(t, level.Error, configuration.stackLevel)
+}
+
+// BenchmarkWithStackLevel perform benchmarking of the WithStackLevel().
+func BenchmarkWithStackLevel(b *testing.B) {
+	configuration := NewConfiguration()
+
+	option := WithStackLevel(level.Error)
+
+	for index := 0; index < b.N; index++ {
+		option(configuration)
+	}
+}
+
 // TestNewConfiguration tests that NewConfiguration creates a new Configuration.
 func TestNewConfiguration(t *testing.T) {
 	tests := map[string]struct {
@@ -1474,6 +1889,217 @@ func BenchmarkEmergency(b *testing.B) {
 	}
 }
 
+// TestSetLocation tests that SetLocation sets location of the default logger
+// and Location returns it.
+func TestSetLocation(t *testing.T) {
+	_, newLogger := createMockedLogger()
+
+	rootLogger = newLogger
+
+	SetLocation(time.UTC)
+
+	testutils.AssertEquals(t, time.UTC, Location())
+}
+
+// BenchmarkSetLocation perform benchmarking of the SetLocation().
+func BenchmarkSetLocation(b *testing.B) {
+	_, newLogger := createMockedLogger()
+
+	rootLogger = newLogger
+
+	for index := 0; index < b.N; index++ {
+		SetLocation(time.UTC)
+	}
+}
+
+// TestSetStackLevel tests that SetStackLevel sets stack level of the default
+// logger and StackLevel returns it.
+func TestSetStackLevel(t *testing.T) {
+	_, newLogger := createMockedLogger()
+
+	rootLogger = newLogger
+
+	SetStackLevel(level.Error)
+
+	testutils.AssertEquals(t, level.Error, StackLevel())
+}
+
+// TestSetLevel tests that SetLevel sets minimum level of the default logger.
+func TestSetLevel(t *testing.T) {
+	_, newLogger := createMockedLogger()
+
+	rootLogger = newLogger
+
+	SetLevel(level.Info)
+
+	testutils.AssertEquals(t, level.Info, Level())
+	testutils.AssertEquals(t, false, Enabled(level.Debug))
+	testutils.AssertEquals(t, true, Enabled(level.Info))
+}
+
+// TestSetRedactor tests that SetRedactor sets a new redactor for the default
+// logger.
+func TestSetRedactor(t *testing.T) {
+	_, newLogger := createMockedLogger()
+
+	rootLogger = newLogger
+
+	redactor := redaction.New("")
+
+	SetRedactor(redactor)
+
+	testutils.AssertEquals(t, redactor, Redactor())
+}
+
+// TestWith tests that With returns a Logger derived from the default logger.
+func TestWith(t *testing.T) {
+	mockLogger, newLogger := createMockedLogger()
+
+	rootLogger = newLogger
+
+	With("request_id", "abc").Info(message, parameters...)
+
+	testutils.AssertEquals(t, "LogFields", mockLogger.CalledName)
+	testutils.AssertEquals(t, any(map[string]interface{}{"request_id": "abc"}), mockLogger.Parameters[2])
+}
+
+// TestNewContext tests that NewContext stores Logger in the context and
+// FromContext retrieves it.
+func TestNewContext(t *testing.T) {
+	_, newLogger := createMockedLogger()
+
+	ctx := NewContext(context.Background(), newLogger)
+
+	testutils.AssertEquals(t, newLogger, FromContext(ctx))
+}
+
+// TestFromContext_Default tests that FromContext returns the default logger, if
+// context does not store Logger.
+func TestFromContext_Default(t *testing.T) {
+	_, newLogger := createMockedLogger()
+
+	rootLogger = newLogger
+
+	testutils.AssertEquals(t, newLogger, FromContext(context.Background()))
+}
+
+// BenchmarkFromContext perform benchmarking of the FromContext().
+func BenchmarkFromContext(b *testing.B) {
+	_, newLogger := createMockedLogger()
+
+	ctx := NewContext(context.Background(), newLogger)
+
+	b.ResetTimer()
+
+	for index := 0; index < b.N; index++ {
+		FromContext(ctx)
+	}
+}
+
+// TestGetLogger tests that GetLogger returns cached loggers that form a
+// hierarchy by their names.
+func TestGetLogger(t *testing.T) {
+	child := GetLogger("hierarchy.child")
+	parent := GetLogger("hierarchy")
+
+	testutils.AssertEquals(t, child, GetLogger("hierarchy.child"))
+	testutils.AssertEquals(t, "hierarchy.child", child.Name())
+	testutils.AssertEquals(t, true, child.Propagate())
+	testutils.AssertEquals(t, parent.baseLogger.(*baseLogger), child.baseLogger.(*baseLogger).parent)
+	testutils.AssertEquals(t, (*baseLogger)(nil), parent.baseLogger.(*baseLogger).parent)
+}
+
+// BenchmarkGetLogger perform benchmarking of the GetLogger().
+func BenchmarkGetLogger(b *testing.B) {
+	for index := 0; index < b.N; index++ {
+		GetLogger("test.benchmark.child")
+	}
+}
+
+// TestGetLogger_Root tests that GetLogger returns the default logger for the
+// empty name and RootName.
+func TestGetLogger_Root(t *testing.T) {
+	newLogger := New(loggerName, timeFormat)
+
+	rootLogger = newLogger
+
+	testutils.AssertEquals(t, newLogger, GetLogger(""))
+	testutils.AssertEquals(t, newLogger, GetLogger(RootName))
+}
+
+// TestGetLogger_Propagation tests that log records of the named logger are
+// written by the handlers of its ancestors unless propagation is disabled, and
+// that level is inherited from the nearest ancestor with configured level.
+func TestGetLogger_Propagation(t *testing.T) {
+	rootBuffer := &bytes.Buffer{}
+	parentBuffer := &bytes.Buffer{}
+
+	newRootLogger := New("root", timeFormat)
+	newRootLogger.AddHandler(handler.New(level.All, level.Null, formatter.New("root:%(name):%(message)"), rootBuffer))
+	rootLogger = newRootLogger
+
+	parent := GetLogger("test.propagation")
+	parent.AddHandler(handler.New(level.All, level.Null, formatter.New("parent:%(name):%(message)"), parentBuffer))
+	parent.SetLevel(level.Info)
+
+	child := GetLogger("test.propagation.db.pool")
+
+	testutils.AssertEquals(t, level.Info, child.EffectiveLevel())
+
+	child.Debug("ignored")
+	child.Info("query")
+
+	GetLogger("test.propagation.db").SetPropagate(false)
+
+	child.Info("cache")
+
+	testutils.AssertEquals(t, "root:test.propagation.db.pool:query\n", rootBuffer.String())
+	testutils.AssertEquals(t, "parent:test.propagation.db.pool:query\n", parentBuffer.String())
+}
+
+// TestCtx tests that Ctx returns Logger stored in the context with fields
+// extracted from the context.
+func TestCtx(t *testing.T) {
+	defer logcontext.UnregisterExtractor("test-request")
+
+	_ = logcontext.RegisterValueExtractor("test-request", "request_id", requestKey{})
+
+	mockLogger, newLogger := createMockedLogger()
+
+	rootLogger = New(loggerName, timeFormat)
+
+	ctx := context.WithValue(NewContext(context.Background(), newLogger), requestKey{}, "abc")
+
+	Ctx(ctx).Info(message, parameters...)
+
+	testutils.AssertEquals(t, "LogFields", mockLogger.CalledName)
+	testutils.AssertEquals(t, any(map[string]interface{}{"request_id": "abc"}), mockLogger.Parameters[2])
+}
+
+// TestWithFields tests that WithFields returns a Logger derived from the
+// default logger.
+func TestWithFields(t *testing.T) {
+	mockLogger, newLogger := createMockedLogger()
+
+	rootLogger = newLogger
+
+	WithFields(map[string]interface{}{"request_id": "abc"}).Info(message, parameters...)
+
+	testutils.AssertEquals(t, "LogFields", mockLogger.CalledName)
+	testutils.AssertEquals(t, any(map[string]interface{}{"request_id": "abc"}), mockLogger.Parameters[2])
+}
+
+// BenchmarkSetStackLevel perform benchmarking of the SetStackLevel().
+func BenchmarkSetStackLevel(b *testing.B) {
+	_, newLogger := createMockedLogger()
+
+	rootLogger = newLogger
+
+	for index := 0; index < b.N; index++ {
+		SetStackLevel(level.Error)
+	}
+}
+
 // TestErrorLevel tests that ErrorLevel returns the error level of the default
 // logger.
 func TestErrorLevel(t *testing.T) {
@@ -0,0 +1,96 @@
+package logger
+
+import (
+	"github.com/dl1998/go-logging/pkg/common/level"
+	"github.com/dl1998/go-logging/pkg/common/stdlog"
+	"log"
+)
+
+const (
+	// writerSkipCallers points caller of the log records to the caller of the
+	// Writer.Write.
+	writerSkipCallers = 4
+	// stdLoggerSkipCallers points caller of the log records to the caller of
+	// the log.Logger method (e.g. log.Printf).
+	stdLoggerSkipCallers = 6
+)
+
+// Writer is an io.Writer that logs every written line as a separate log record
+// using the Logger. Incomplete line is kept till the next write or Flush.
+type Writer struct {
+	logger      *Logger
+	level       level.Level
+	parseLevel  bool
+	skipCallers int
+	lines       stdlog.LineBuffer
+}
+
+// NewWriter creates a new instance of the Writer that logs lines with the
+// provided level.Level. If parseLevel is true, level is taken from the prefix
+// of the line (e.g. "[ERROR] message", see stdlog.ParseLevel), lines without
+// prefix are logged with the provided level.Level.
+func NewWriter(logger *Logger, logLevel level.Level, parseLevel bool) *Writer {
+	return &Writer{
+		logger:      logger,
+		level:       logLevel,
+		parseLevel:  parseLevel,
+		skipCallers: writerSkipCallers,
+	}
+}
+
+// Write logs complete lines from the data as plain text, neither format verbs
+// nor placeholders in the lines are expanded. It never returns error.
+func (writer *Writer) Write(data []byte) (int, error) {
+	for _, line := range writer.lines.Split(data) {
+		logLevel, message := writer.levelOf(line)
+		writer.logger.baseLogger.Log(logLevel, writer.skipCallers, "%s", message)
+	}
+	return len(data), nil
+}
+
+// Flush logs incomplete line kept by the Writer.
+func (writer *Writer) Flush() {
+	if line, ok := writer.lines.Flush(); ok {
+		logLevel, message := writer.levelOf(line)
+		writer.logger.baseLogger.Log(logLevel, writer.skipCallers, "%s", message)
+	}
+}
+
+// levelOf returns level.Level and message of the line.
+func (writer *Writer) levelOf(line string) (level.Level, string) {
+	if !writer.parseLevel {
+		return writer.level, line
+	}
+	return stdlog.ParseLevel(line, writer.level)
+}
+
+// NewStdLogger creates a new log.Logger that writes through the Logger, e.g.
+// for http.Server.ErrorLog. Every line is logged with the provided level.Level
+// or with the level from its prefix, if parseLevel is true (see NewWriter).
+// Caller of the log records is the caller of the log.Logger methods.
+func NewStdLogger(logger *Logger, logLevel level.Level, parseLevel bool) *log.Logger {
+	writer := NewWriter(logger, logLevel, parseLevel)
+	writer.skipCallers = stdLoggerSkipCallers
+	return log.New(writer, "", 0)
+}
+
+// RedirectStdLog redirects output of the standard library log package to the
+// Logger (see NewStdLogger), flags and prefix of the log package are reset,
+// because time and caller are added by the formatters. It returns function that
+// restores previous output, flags and prefix.
+func RedirectStdLog(logger *Logger, logLevel level.Level, parseLevel bool) func() {
+	flags, prefix, output := log.Flags(), log.Prefix(), log.Writer()
+
+	writer := NewWriter(logger, logLevel, parseLevel)
+	writer.skipCallers = stdLoggerSkipCallers
+
+	log.SetFlags(0)
+	log.SetPrefix("")
+	log.SetOutput(writer)
+
+	return func() {
+		log.SetFlags(flags)
+		log.SetPrefix(prefix)
+		log.SetOutput(output)
+	}
+}
@@ -3,9 +3,11 @@ package structuredlogger
 import (
 	"fmt"
 	"github.com/dl1998/go-logging/pkg/common/level"
+	commonlogrecord "github.com/dl1998/go-logging/pkg/common/logrecord"
 	"github.com/dl1998/go-logging/pkg/structuredlogger/handler"
 	"github.com/dl1998/go-logging/pkg/structuredlogger/logrecord"
 	"sync"
+	"time"
 )
 
 // baseAsyncLogger struct contains basic fields for the async structured logger.
@@ -56,10 +58,21 @@ func (logger *baseAsyncLogger) Close() {
 
 // Log logs interpolated message with the provided level.Level.
 func (logger *baseAsyncLogger) Log(logLevel level.Level, skipCallers int, parameters ...any) {
+	if !logger.Enabled(logLevel) {
+		return
+	}
+	logger.waitGroup.Add(1)
+	logger.messageQueue <- logger.newRecord(logLevel, skipCallers, parameters, commonlogrecord.WithCaller(!logger.withoutCaller))
+}
+
+// LogSource logs parameters with the provided level.Level, place in the code
+// is resolved from the program counter, zero time means the current time.
+func (logger *baseAsyncLogger) LogSource(logLevel level.Level, programCounter uintptr, timestamp time.Time, parameters ...any) {
+	if !logger.Enabled(logLevel) {
+		return
+	}
 	logger.waitGroup.Add(1)
-	var parametersMap = convertParametersToMap(parameters...)
-	logRecord := logrecord.New(logger.name, logLevel, logger.timeFormat, parametersMap, skipCallers)
-	logger.messageQueue <- logRecord
+	logger.messageQueue <- logger.newRecord(logLevel, 2, parameters, logger.sourceOption(programCounter), commonlogrecord.WithTime(timestamp))
 }
 
 // AsyncLoggerInterface defines async structured logger interface.
@@ -82,7 +95,10 @@ func NewAsyncLogger(name string, timeFormat string, queueSize int) *AsyncLogger
 		baseLogger: &baseLogger{
 			name:       name,
 			timeFormat: timeFormat,
+			stackLevel: level.Null,
+			clock:      commonlogrecord.NewClock(),
 			handlers:   make([]handler.Interface, 0),
+			propagate:  true,
 		},
 		messageQueue:  make(chan logrecord.Interface, queueSize),
 		isChannelOpen: true,
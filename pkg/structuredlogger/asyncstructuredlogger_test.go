@@ -121,7 +121,7 @@ func TestBaseAsyncLogger_Log(t *testing.T) {
 	mockHandler := &MockHandler{}
 	newBaseAsyncLogger := createBaseAsyncLogger([]handler.Interface{mockHandler}, messageQueueSize, true)
 
-	newBaseAsyncLogger.Log(level.Trace, skipCallers, parametersWithMap)
+	newBaseAsyncLogger.Log(level.Debug, skipCallers, parametersWithMap)
 
 	record := <-newBaseAsyncLogger.messageQueue
 
@@ -138,7 +138,7 @@ func BenchmarkBaseAsyncLogger_Log(b *testing.B) {
 	b.ResetTimer()
 
 	for index := 0; index < b.N; index++ {
-		newBaseAsyncLogger.Log(level.Trace, skipCallers, parametersWithMap)
+		newBaseAsyncLogger.Log(level.Debug, skipCallers, parametersWithMap)
 	}
 }
 
@@ -181,6 +181,22 @@ func TestAsyncLogger_WaitToFinishLogging(t *testing.T) {
 	testutils.AssertEquals(t, true, waited)
 }
 
+// TestAsyncLogger_With tests that Logger derived from the AsyncLogger sends
+// records with bound fields on the message queue of the AsyncLogger.
+func TestAsyncLogger_With(t *testing.T) {
+	mockHandler := &MockHandler{}
+	newAsyncLogger := &AsyncLogger{
+		Logger: &Logger{
+			baseLogger: createBaseAsyncLogger([]handler.Interface{mockHandler}, messageQueueSize, true),
+		},
+	}
+
+	newAsyncLogger.With("request_id", "abc").Debug(parameters...)
+	record := <-newAsyncLogger.baseLogger.(*baseAsyncLogger).messageQueue
+
+	testutils.AssertEquals(t, map[string]interface{}{"request_id": "abc", "message": "test"}, record.Parameters())
+}
+
 // TestAsyncLogger_Open tests that AsyncLogger.Open creates a new message queue
 // and start listening messages.
 func TestAsyncLogger_Open(t *testing.T) {
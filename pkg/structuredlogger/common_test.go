@@ -3,10 +3,12 @@ package structuredlogger
 import (
 	"github.com/dl1998/go-logging/internal/testutils"
 	"github.com/dl1998/go-logging/pkg/common/level"
+	"github.com/dl1998/go-logging/pkg/common/redaction"
 	"github.com/dl1998/go-logging/pkg/structuredlogger/formatter"
 	"github.com/dl1998/go-logging/pkg/structuredlogger/handler"
 	"github.com/dl1998/go-logging/pkg/structuredlogger/logrecord"
 	"io"
+	"runtime"
 	"testing"
 	"time"
 )
@@ -32,11 +34,16 @@ var loggerTemplate = map[string]string{
 
 // MockLogger is used to mock baseLogger.
 type MockLogger struct {
-	handlers   []handler.Interface
-	CalledName string
-	Called     bool
-	Parameters []any
-	Return     any
+	handlers     []handler.Interface
+	location     *time.Location
+	stackLevel   level.Level
+	redactor     *redaction.Redactor
+	minimumLevel level.Level
+	propagate    bool
+	CalledName   string
+	Called       bool
+	Parameters   []any
+	Return       any
 }
 
 // Log mocks Log from baseLogger.
@@ -48,6 +55,15 @@ func (mock *MockLogger) Log(level level.Level, skipCaller int, parameters ...any
 	mock.Return = nil
 }
 
+// LogSource mocks LogSource from baseLogger.
+func (mock *MockLogger) LogSource(level level.Level, programCounter uintptr, timestamp time.Time, parameters ...any) {
+	mock.CalledName = "LogSource"
+	mock.Called = true
+	mock.Parameters = append(make([]any, 0), level, programCounter)
+	mock.Parameters = append(mock.Parameters, parameters...)
+	mock.Return = nil
+}
+
 // Name mocks Name from baseLogger.
 func (mock *MockLogger) Name() string {
 	mock.CalledName = "SetName"
@@ -95,6 +111,114 @@ func (mock *MockLogger) RemoveHandler(handlerInterface handler.Interface) {
 	mock.Return = nil
 }
 
+// Location mocks Location from baseLogger.
+func (mock *MockLogger) Location() *time.Location {
+	mock.CalledName = "Location"
+	mock.Called = true
+	mock.Parameters = make([]any, 0)
+	mock.Return = mock.location
+	return mock.location
+}
+
+// SetLocation mocks SetLocation from baseLogger.
+func (mock *MockLogger) SetLocation(location *time.Location) {
+	mock.CalledName = "SetLocation"
+	mock.Called = true
+	mock.Parameters = append(make([]any, 0), location)
+	mock.location = location
+	mock.Return = nil
+}
+
+// StackLevel mocks StackLevel from baseLogger.
+func (mock *MockLogger) StackLevel() level.Level {
+	mock.CalledName = "StackLevel"
+	mock.Called = true
+	mock.Parameters = make([]any, 0)
+	mock.Return = mock.stackLevel
+	return mock.stackLevel
+}
+
+// SetStackLevel mocks SetStackLevel from baseLogger.
+func (mock *MockLogger) SetStackLevel(stackLevel level.Level) {
+	mock.CalledName = "SetStackLevel"
+	mock.Called = true
+	mock.Parameters = append(make([]any, 0), stackLevel)
+	mock.stackLevel = stackLevel
+	mock.Return = nil
+}
+
+// Redactor mocks Redactor from baseLogger.
+func (mock *MockLogger) Redactor() *redaction.Redactor {
+	mock.CalledName = "Redactor"
+	mock.Called = true
+	mock.Parameters = make([]any, 0)
+	mock.Return = mock.redactor
+	return mock.redactor
+}
+
+// SetRedactor mocks SetRedactor from baseLogger.
+func (mock *MockLogger) SetRedactor(redactor *redaction.Redactor) {
+	mock.CalledName = "SetRedactor"
+	mock.Called = true
+	mock.Parameters = append(make([]any, 0), redactor)
+	mock.redactor = redactor
+	mock.Return = nil
+}
+
+// Level mocks Level from baseLogger.
+func (mock *MockLogger) Level() level.Level {
+	mock.CalledName = "Level"
+	mock.Called = true
+	mock.Parameters = make([]any, 0)
+	mock.Return = mock.minimumLevel
+	return mock.minimumLevel
+}
+
+// SetLevel mocks SetLevel from baseLogger.
+func (mock *MockLogger) SetLevel(minimumLevel level.Level) {
+	mock.CalledName = "SetLevel"
+	mock.Called = true
+	mock.Parameters = append(make([]any, 0), minimumLevel)
+	mock.minimumLevel = minimumLevel
+	mock.Return = nil
+}
+
+// EffectiveLevel mocks EffectiveLevel from baseLogger.
+func (mock *MockLogger) EffectiveLevel() level.Level {
+	mock.CalledName = "EffectiveLevel"
+	mock.Called = true
+	mock.Parameters = make([]any, 0)
+	mock.Return = mock.minimumLevel
+	return mock.minimumLevel
+}
+
+// Enabled mocks Enabled from baseLogger.
+func (mock *MockLogger) Enabled(logLevel level.Level) bool {
+	mock.CalledName = "Enabled"
+	mock.Called = true
+	mock.Parameters = append(make([]any, 0), logLevel)
+	mock.Return = logLevel >= mock.minimumLevel
+	return logLevel >= mock.minimumLevel
+}
+
+// Propagate mocks Propagate from baseLogger.
+func (mock *MockLogger) Propagate() bool {
+	mock.CalledName = "Propagate"
+	mock.Called = true
+	mock.Parameters = make([]any, 0)
+	mock.Return = mock.propagate
+	return mock.propagate
+}
+
+// SetPropagate mocks SetPropagate from baseLogger.
+func (mock *MockLogger) SetPropagate(propagate bool) {
+	mock.CalledName = "SetPropagate"
+	mock.Called = true
+	mock.Parameters = append(make([]any, 0), propagate)
+	mock.propagate = propagate
+	mock.Return = nil
+}
+
 // MockHandler is used to mock Handler.
 type MockHandler struct {
 	writer     io.Writer
@@ -258,6 +382,142 @@ func BenchmarkBaseLogger_Log(b *testing.B) {
 	}
 }
 
+// TestBaseLogger_LogSource tests that baseLogger.LogSource resolves place in
+// the code from the program counter.
+func TestBaseLogger_LogSource(t *testing.T) {
+	newHandler := &MockHandler{}
+
+	newBaseLogger := &baseLogger{
+		name: loggerName,
+		handlers: []handler.Interface{
+			newHandler,
+		},
+	}
+
+	programCounter, file, line, _ := runtime.Caller(0)
+
+	newBaseLogger.LogSource(logLevel, programCounter, time.Time{}, parameters...)
+
+	logRecord := newHandler.Parameters[0].(*logrecord.LogRecord)
+
+	testutils.AssertEquals(t, file, logRecord.FileName())
+	testutils.AssertEquals(t, line, logRecord.FileLine())
+	testutils.AssertEquals(t, parametersWithMap, logRecord.Parameters())
+
+	newBaseLogger.LogSource(logLevel, 0, time.Time{}, parameters...)
+
+	logRecord = newHandler.Parameters[0].(*logrecord.LogRecord)
+
+	testutils.AssertEquals(t, "", logRecord.FileName())
+	testutils.AssertEquals(t, 0, logRecord.FileLine())
+}
+
+// BenchmarkBaseLogger_LogSource perform benchmarking of the
+// baseLogger.LogSource().
+func BenchmarkBaseLogger_LogSource(b *testing.B) {
+	newBaseLogger := &baseLogger{
+		name: loggerName,
+		handlers: []handler.Interface{
+			&MockHandler{},
+		},
+	}
+
+	programCounter, _, _, _ := runtime.Caller(0)
+
+	for index := 0; index < b.N; index++ {
+		newBaseLogger.LogSource(logLevel, programCounter, time.Time{}, parameters...)
+	}
+}
+
+// TestBaseLogger_Log_WithoutCaller tests that baseLogger.Log does not capture
+// caller, if none of the formatters uses it.
+func TestBaseLogger_Log_WithoutCaller(t *testing.T) {
+	newHandler := &MockHandler{}
+
+	newBaseLogger := &baseLogger{
+		name:     loggerName,
+		handlers: make([]handler.Interface, 0),
+	}
+	newBaseLogger.AddHandler(newHandler)
+
+	newBaseLogger.Log(logLevel, skipCallers, parameters...)
+
+	handlerRecord := newHandler.Parameters[0].(*logrecord.LogRecord)
+
+	testutils.AssertEquals(t, "", handlerRecord.FileName())
+	testutils.AssertEquals(t, 0, handlerRecord.FileLine())
+}
+
+// BenchmarkBaseLogger_Log_CallerLookup perform benchmarking of the
+// baseLogger.Log() with and without lookup of the caller.
+func BenchmarkBaseLogger_Log_CallerLookup(b *testing.B) {
+	benchmarks := map[string]bool{
+		"With Caller":    false,
+		"Without Caller": true,
+	}
+
+	for name, withoutCaller := range benchmarks {
+		newBaseLogger := &baseLogger{
+			name: loggerName,
+			handlers: []handler.Interface{
+				&MockHandler{},
+			},
+			withoutCaller: withoutCaller,
+		}
+
+		b.Run(name, func(b *testing.B) {
+			for index := 0; index < b.N; index++ {
+				newBaseLogger.Log(logLevel, skipCallers, parameters...)
+			}
+		})
+	}
+}
+
+// TestBindFields tests that bindFields merges fields of the parent boundLogger
+// and wraps the same base logger.
+func TestBindFields(t *testing.T) {
+	mockLogger := &MockLogger{}
+
+	parent := bindFields(mockLogger, map[string]interface{}{"request_id": "abc", "user": "john"})
+	child := bindFields(parent, map[string]interface{}{"user": "jane"})
+
+	testutils.AssertEquals(t, baseLoggerInterface(mockLogger), child.(*boundLogger).baseLoggerInterface)
+	testutils.AssertEquals(t, map[string]interface{}{"request_id": "abc", "user": "john"}, parent.(*boundLogger).fields)
+	testutils.AssertEquals(t, map[string]interface{}{"request_id": "abc", "user": "jane"}, child.(*boundLogger).fields)
+}
+
+// BenchmarkBindFields perform benchmarking of the bindFields().
+func BenchmarkBindFields(b *testing.B) {
+	mockLogger := &MockLogger{}
+	fields := map[string]interface{}{"request_id": "abc"}
+
+	for index := 0; index < b.N; index++ {
+		bindFields(mockLogger, fields)
+	}
+}
+
+// TestBoundLogger_Log tests that boundLogger.Log merges bound fields into the
+// parameters, parameters take precedence over the bound fields.
+func TestBoundLogger_Log(t *testing.T) {
+	mockLogger := &MockLogger{}
+
+	bound := bindFields(mockLogger, map[string]interface{}{"request_id": "abc", "message": "bound"})
+	bound.Log(logLevel, skipCallers, parameters...)
+
+	expected := map[string]interface{}{"request_id": "abc", "message": "test"}
+
+	testutils.AssertEquals(t, []any{logLevel, skipCallers + 1, expected}, mockLogger.Parameters)
+}
+
+// BenchmarkBoundLogger_Log perform benchmarking of the boundLogger.Log().
+func BenchmarkBoundLogger_Log(b *testing.B) {
+	bound := bindFields(&MockLogger{}, map[string]interface{}{"request_id": "abc"})
+
+	for index := 0; index < b.N; index++ {
+		bound.Log(logLevel, skipCallers, parameters...)
+	}
+}
+
 // TestBaseLogger_Name tests that baseLogger.Name returns name of the logger.
 func TestBaseLogger_Name(t *testing.T) {
 	newBaseLogger := &baseLogger{
@@ -317,6 +577,141 @@ func BenchmarkBaseLogger_SetName(b *testing.B) {
 	}
 }
 
+// TestBaseLogger_Log_Location tests that baseLogger.Log creates log records in
+// the location of the logger.
+func TestBaseLogger_Log_Location(t *testing.T) {
+	newHandler := &MockHandler{}
+
+	newBaseLogger := &baseLogger{
+		name:     loggerName,
+		location: time.UTC,
+		handlers: []handler.Interface{
+			newHandler,
+		},
+	}
+
+	newBaseLogger.Log(logLevel, skipCallers, parameters...)
+
+	handlerRecord := newHandler.Parameters[0].(*logrecord.LogRecord)
+
+	testutils.AssertEquals(t, time.UTC, handlerRecord.RawTime().Location())
+}
+
+// TestBaseLogger_Log_Stack tests that baseLogger.Log attaches stack of the
+// goroutine to the log records starting from the stack level.
+func TestBaseLogger_Log_Stack(t *testing.T) {
+	tests := map[string]struct {
+		stackLevel level.Level
+		expected   bool
+	}{
+		"Below stack level": {stackLevel: level.Error, expected: false},
+		"Above stack level": {stackLevel: level.Debug, expected: true},
+		"Disabled":          {stackLevel: level.Null, expected: false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			newHandler := &MockHandler{}
+
+			newBaseLogger := &baseLogger{
+				name:       loggerName,
+				stackLevel: test.stackLevel,
+				handlers: []handler.Interface{
+					newHandler,
+				},
+			}
+
+			newBaseLogger.Log(logLevel, skipCallers, parameters...)
+
+			handlerRecord := newHandler.Parameters[0].(*logrecord.LogRecord)
+
+			testutils.AssertEquals(t, test.expected, len(handlerRecord.Stack()) > 0)
+		})
+	}
+}
+
+// TestBaseLogger_SetRedactor tests that baseLogger.SetRedactor sets a new
+// redactor for the baseLogger.
+func TestBaseLogger_SetRedactor(t *testing.T) {
+	newBaseLogger := &baseLogger{
+		name: loggerName,
+	}
+
+	redactor := redaction.New("")
+
+	newBaseLogger.SetRedactor(redactor)
+
+	testutils.AssertEquals(t, redactor, newBaseLogger.Redactor())
+}
+
+// TestBaseLogger_Log_Redaction tests that baseLogger.Log applies redaction
+// rules to the parameters.
+func TestBaseLogger_Log_Redaction(t *testing.T) {
+	newHandler := &MockHandler{}
+
+	newBaseLogger := &baseLogger{
+		name: loggerName,
+		handlers: []handler.Interface{
+			newHandler,
+		},
+		redactor: redaction.New("", redaction.Rule{Key: "password", Action: redaction.Drop}),
+	}
+
+	newBaseLogger.Log(level.Debug, skipCallers, "user", "john", "password", "secret")
+
+	handlerRecord := newHandler.Parameters[0].(*logrecord.LogRecord)
+
+	testutils.AssertEquals(t, map[string]interface{}{"user": "john"}, handlerRecord.Parameters())
+}
+
+// TestBaseLogger_SetStackLevel tests that baseLogger.SetStackLevel sets a new
+// stack level for the logger.
+func TestBaseLogger_SetStackLevel(t *testing.T) {
+	newBaseLogger := &baseLogger{
+		name: loggerName,
+	}
+
+	newBaseLogger.SetStackLevel(level.Error)
+
+	testutils.AssertEquals(t, level.Error, newBaseLogger.StackLevel())
+}
+
+// BenchmarkBaseLogger_SetStackLevel perform benchmarking of the
+// baseLogger.SetStackLevel().
+func BenchmarkBaseLogger_SetStackLevel(b *testing.B) {
+	newBaseLogger := &baseLogger{
+		name: loggerName,
+	}
+
+	for index := 0; index < b.N; index++ {
+		newBaseLogger.SetStackLevel(level.Error)
+	}
+}
+
+// TestBaseLogger_SetLocation tests that baseLogger.SetLocation sets a new
+// location for the logger.
+func TestBaseLogger_SetLocation(t *testing.T) {
+	newBaseLogger := &baseLogger{
+		name: loggerName,
+	}
+
+	newBaseLogger.SetLocation(time.UTC)
+
+	testutils.AssertEquals(t, time.UTC, newBaseLogger.Location())
+}
+
+// BenchmarkBaseLogger_SetLocation perform benchmarking of the
+// baseLogger.SetLocation().
+func BenchmarkBaseLogger_SetLocation(b *testing.B) {
+	newBaseLogger := &baseLogger{
+		name: loggerName,
+	}
+
+	for index := 0; index < b.N; index++ {
+		newBaseLogger.SetLocation(time.UTC)
+	}
+}
+
 // TestBaseLogger_Handlers tests that baseLogger.Handlers returns a list of
 // handlers for the logger.
 func TestBaseLogger_Handlers(t *testing.T) {
@@ -377,6 +772,53 @@ func BenchmarkBaseLogger_AddHandler(b *testing.B) {
 	}
 }
 
+// TestBaseLogger_AddHandler_CallerLookup tests that baseLogger.AddHandler
+// disables lookup of the caller only if none of the formatters uses it.
+func TestBaseLogger_AddHandler_CallerLookup(t *testing.T) {
+	newBaseLogger := &baseLogger{
+		name:     loggerName,
+		handlers: make([]handler.Interface, 0),
+	}
+
+	newBaseLogger.AddHandler(&MockHandler{})
+
+	testutils.AssertEquals(t, true, newBaseLogger.withoutCaller)
+
+	callerHandler := handler.New(level.All, level.Null, formatter.NewJSON(map[string]string{"file": "%(fname)"}, pretty), io.Discard)
+
+	newBaseLogger.AddHandler(callerHandler)
+
+	testutils.AssertEquals(t, false, newBaseLogger.withoutCaller)
+
+	newBaseLogger.RemoveHandler(callerHandler)
+
+	testutils.AssertEquals(t, true, newBaseLogger.withoutCaller)
+}
+
+// TestBaseLogger_AddHandler_GoroutineCapture tests that baseLogger.AddHandler
+// enables capture of the goroutine identifier only if any of the formatters
+// uses it.
+func TestBaseLogger_AddHandler_GoroutineCapture(t *testing.T) {
+	newBaseLogger := &baseLogger{
+		name:     loggerName,
+		handlers: make([]handler.Interface, 0),
+	}
+
+	newBaseLogger.AddHandler(&MockHandler{})
+
+	testutils.AssertEquals(t, false, newBaseLogger.withGoroutine)
+
+	goroutineHandler := handler.New(level.All, level.Null, formatter.NewJSON(map[string]string{"goroutine": "%(goroutine)"}, pretty), io.Discard)
+
+	newBaseLogger.AddHandler(goroutineHandler)
+
+	testutils.AssertEquals(t, true, newBaseLogger.withGoroutine)
+
+	newBaseLogger.RemoveHandler(goroutineHandler)
+
+	testutils.AssertEquals(t, false, newBaseLogger.withGoroutine)
+}
+
 // TestBaseLogger_RemoveHandler tests that baseLogger.RemoveHandler removes a
 // Handler from the list of handlers.
 func TestBaseLogger_RemoveHandler(t *testing.T) {
@@ -405,3 +847,139 @@ func BenchmarkBaseLogger_RemoveHandler(b *testing.B) {
 		newBaseLogger.RemoveHandler(newHandler)
 	}
 }
+
+// TestBaseLogger_EffectiveLevel tests that baseLogger.EffectiveLevel returns
+// level of the nearest ancestor with configured level.
+func TestBaseLogger_EffectiveLevel(t *testing.T) {
+	grandparent := &baseLogger{name: "app", level: level.Warning}
+	parent := &baseLogger{name: "app.db", parent: grandparent}
+	child := &baseLogger{name: "app.db.pool", parent: parent}
+
+	testutils.AssertEquals(t, level.Warning, child.EffectiveLevel())
+
+	parent.SetLevel(level.Debug)
+
+	testutils.AssertEquals(t, level.Debug, child.EffectiveLevel())
+	testutils.AssertEquals(t, level.All, child.Level())
+	testutils.AssertEquals(t, level.All, (&baseLogger{}).EffectiveLevel())
+}
+
+// BenchmarkBaseLogger_EffectiveLevel perform benchmarking of the
+// baseLogger.EffectiveLevel().
+func BenchmarkBaseLogger_EffectiveLevel(b *testing.B) {
+	grandparent := &baseLogger{name: "app", level: level.Warning}
+	parent := &baseLogger{name: "app.db", parent: grandparent}
+	child := &baseLogger{name: "app.db.pool", parent: parent}
+
+	for index := 0; index < b.N; index++ {
+		child.EffectiveLevel()
+	}
+}
+
+// TestBaseLogger_Log_Level tests that baseLogger.Log discards log records with
+// level lower than the effective level.
+func TestBaseLogger_Log_Level(t *testing.T) {
+	newHandler := &MockHandler{}
+
+	newBaseLogger := &baseLogger{
+		name:     loggerName,
+		handlers: []handler.Interface{newHandler},
+		level:    level.Info,
+	}
+
+	newBaseLogger.Log(level.Debug, skipCallers, "message", "value")
+
+	testutils.AssertEquals(t, false, newHandler.Called)
+
+	newBaseLogger.Log(level.Info, skipCallers, "message", "value")
+
+	testutils.AssertEquals(t, true, newHandler.Called)
+}
+
+// TestBaseLogger_Log_Propagation tests that baseLogger.Log writes log record
+// to the handlers of the ancestors until logger with disabled propagation.
+func TestBaseLogger_Log_Propagation(t *testing.T) {
+	rootHandler := &MockHandler{}
+	parentHandler := &MockHandler{}
+	childHandler := &MockHandler{}
+
+	root := &baseLogger{name: "app", handlers: []handler.Interface{rootHandler}, propagate: true}
+	parent := &baseLogger{name: "app.db", handlers: []handler.Interface{parentHandler}, parent: root}
+	child := &baseLogger{name: "app.db.pool", handlers: []handler.Interface{childHandler}, parent: parent, propagate: true}
+
+	child.Log(logLevel, skipCallers, "message", "value")
+
+	testutils.AssertEquals(t, true, childHandler.Called)
+	testutils.AssertEquals(t, true, parentHandler.Called)
+	testutils.AssertEquals(t, false, rootHandler.Called)
+	testutils.AssertEquals(t, "app.db.pool", parentHandler.Parameters[0].(*logrecord.LogRecord).Name())
+}
+
+// BenchmarkBaseLogger_Log_Propagation perform benchmarking of the
+// baseLogger.Log() with propagation to the parent logger.
+func BenchmarkBaseLogger_Log_Propagation(b *testing.B) {
+	parent := &baseLogger{name: "app", handlers: []handler.Interface{&MockHandler{}}, withoutCaller: true}
+	child := &baseLogger{name: "app.db", parent: parent, propagate: true, withoutCaller: true}
+
+	for index := 0; index < b.N; index++ {
+		child.Log(logLevel, skipCallers, "message", "value")
+	}
+}
+
+// TestBaseLogger_CapturesCaller tests that baseLogger captures caller, if it is
+// used by the handlers of the ancestors that receive log records.
+func TestBaseLogger_CapturesCaller(t *testing.T) {
+	parent := &baseLogger{name: "app"}
+	child := &baseLogger{name: "app.db", parent: parent, propagate: true, withoutCaller: true, withGoroutine: false}
+
+	testutils.AssertEquals(t, true, child.capturesCaller())
+	testutils.AssertEquals(t, false, child.capturesGoroutine())
+
+	parent.withoutCaller = true
+	parent.withGoroutine = true
+
+	testutils.AssertEquals(t, false, child.capturesCaller())
+	testutils.AssertEquals(t, true, child.capturesGoroutine())
+
+	child.SetPropagate(false)
+
+	testutils.AssertEquals(t, false, child.Propagate())
+	testutils.AssertEquals(t, false, child.capturesGoroutine())
+}
+
+// TestBaseLogger_Enabled tests that baseLogger.Enabled checks effective level
+// and levels accepted by the handlers.
+func TestBaseLogger_Enabled(t *testing.T) {
+	fileHandler := handler.New(level.Warning, level.Null, formatter.NewJSON(map[string]string{}, false), io.Discard)
+	consoleHandler := handler.New(level.Info, level.Null, formatter.NewJSON(map[string]string{}, false), io.Discard)
+
+	parent := &baseLogger{name: "app", handlers: []handler.Interface{consoleHandler}}
+	child := &baseLogger{name: "app.db", handlers: []handler.Interface{fileHandler}, parent: parent, propagate: true}
+
+	testutils.AssertEquals(t, false, child.Enabled(level.Debug))
+	testutils.AssertEquals(t, true, child.Enabled(level.Info))
+
+	child.SetPropagate(false)
+
+	testutils.AssertEquals(t, false, child.Enabled(level.Info))
+
+	child.SetLevel(level.Error)
+
+	testutils.AssertEquals(t, false, child.Enabled(level.Warning))
+	testutils.AssertEquals(t, true, child.Enabled(level.Error))
+	testutils.AssertEquals(t, false, (&baseLogger{}).Enabled(level.Emergency))
+}
+
+// BenchmarkBaseLogger_Enabled perform benchmarking of the baseLogger.Enabled().
+func BenchmarkBaseLogger_Enabled(b *testing.B) {
+	newBaseLogger := &baseLogger{
+		name:     loggerName,
+		handlers: []handler.Interface{handler.New(level.Info, level.Null, formatter.NewJSON(map[string]string{}, false), io.Discard)},
+	}
+
+	b.ReportAllocs()
+
+	for index := 0; index < b.N; index++ {
+		newBaseLogger.Enabled(level.Debug)
+	}
+}
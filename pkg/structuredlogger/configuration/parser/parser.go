@@ -3,11 +3,14 @@ package parser
 
 import (
 	"github.com/dl1998/go-logging/pkg/common/configuration/parser"
+	commonformatter "github.com/dl1998/go-logging/pkg/common/formatter"
 	"github.com/dl1998/go-logging/pkg/common/level"
+	"github.com/dl1998/go-logging/pkg/common/redaction"
 	"github.com/dl1998/go-logging/pkg/structuredlogger"
 	"github.com/dl1998/go-logging/pkg/structuredlogger/formatter"
 	"github.com/dl1998/go-logging/pkg/structuredlogger/handler"
 	"strings"
+	"time"
 )
 
 // Parser is the configuration parser for the structured logger.
@@ -18,8 +21,12 @@ type Parser struct {
 }
 
 // NewParser returns a new instance of the Parser with the given
-// parser.Configuration.
+// parser.Configuration. It registers placeholders from the configuration and
+// panics if any of them is invalid.
 func NewParser(configuration parser.Configuration) *Parser {
+	if err := configuration.RegisterPlaceholders(); err != nil {
+		panic(err)
+	}
 	return &Parser{configuration: &configuration}
 }
 
@@ -51,14 +58,58 @@ func ParseXML(file string) (*Parser, error) {
 // parseFormatter parses parser.FormatterConfiguration configuration and returns
 // formatter.Interface.
 func (parser *Parser) parseFormatter(configuration parser.FormatterConfiguration) formatter.Interface {
+	var newFormatter formatter.Interface
 	switch configuration.Type {
 	case "json":
-		return formatter.NewJSON(configuration.Template.MapValue, configuration.PrettyPrint)
+		newFormatter = formatter.NewJSON(configuration.Template.MapValue, configuration.PrettyPrint)
 	case "key-value":
-		return formatter.NewKeyValue(configuration.Template.MapValue, configuration.KeyValueDelimiter, configuration.PairSeparator)
+		newFormatter = formatter.NewKeyValue(configuration.Template.MapValue, configuration.KeyValueDelimiter, configuration.PairSeparator)
+	case "console":
+		newFormatter = formatter.NewConsole(configuration.Template.MapValue)
+	case "template":
+		newFormatter = formatter.NewTemplate(string(configuration.Template.StringValue))
 	default:
 		panic("unknown formatter type.")
 	}
+	parser.parseSanitizeMode(configuration, newFormatter)
+	parser.parseColorScheme(configuration, newFormatter)
+	return newFormatter
+}
+
+// parseSanitizeMode parses sanitization mode from
+// parser.FormatterConfiguration configuration and sets it to the formatter, it
+// panics if sanitization mode is unknown.
+func (parser *Parser) parseSanitizeMode(configuration parser.FormatterConfiguration, newFormatter formatter.Interface) {
+	mode, err := configuration.SanitizeMode()
+	if err != nil {
+		panic(err)
+	}
+	if sanitizable, ok := newFormatter.(commonformatter.Sanitizable); ok && configuration.Sanitize != "" {
+		sanitizable.SetSanitizeMode(mode)
+	}
+}
+
+// parseColorScheme parses color scheme from parser.FormatterConfiguration
+// configuration and sets it to the formatter, it panics if color scheme is
+// invalid.
+func (parser *Parser) parseColorScheme(configuration parser.FormatterConfiguration, newFormatter formatter.Interface) {
+	scheme, err := configuration.Colors.ColorScheme()
+	if err != nil {
+		panic(err)
+	}
+	if colorable, ok := newFormatter.(commonformatter.Colorable); ok && scheme != nil {
+		colorable.SetColorScheme(scheme)
+	}
+}
+
+// parseHandlerFormatter parses formatter of the parser.HandlerConfiguration
+// configuration and sets limits of the handler to it.
+func (parser *Parser) parseHandlerFormatter(configuration parser.HandlerConfiguration) formatter.Interface {
+	newFormatter := parser.parseFormatter(configuration.Formatter)
+	if limitable, ok := newFormatter.(commonformatter.Limitable); ok {
+		limitable.SetLimits(configuration.Limits.Limits())
+	}
+	return newFormatter
 }
 
 // parseHandler parses parser.HandlerConfiguration configuration and returns
@@ -66,32 +117,104 @@ func (parser *Parser) parseFormatter(configuration parser.FormatterConfiguration
 func (parser *Parser) parseHandler(configuration parser.HandlerConfiguration) handler.Interface {
 	fromLevel := level.ParseLevel(strings.ToLower(configuration.FromLevel))
 	toLevel := level.ParseLevel(strings.ToLower(configuration.ToLevel))
+	var newHandler *handler.Handler
 	switch configuration.Type {
 	case "stdout":
-		return handler.NewConsoleHandler(fromLevel, toLevel, parser.parseFormatter(configuration.Formatter))
+		newHandler = handler.NewConsoleHandler(fromLevel, toLevel, parser.parseHandlerFormatter(configuration))
 	case "stderr":
-		return handler.NewConsoleErrorHandler(fromLevel, toLevel, parser.parseFormatter(configuration.Formatter))
+		newHandler = handler.NewConsoleErrorHandler(fromLevel, toLevel, parser.parseHandlerFormatter(configuration))
 	case "file":
 		if configuration.File == "" {
 			panic("file handler requires file option.")
 		}
-		return handler.NewFileHandler(fromLevel, toLevel, parser.parseFormatter(configuration.Formatter), configuration.File)
+		newHandler = handler.NewFileHandler(fromLevel, toLevel, parser.parseHandlerFormatter(configuration), configuration.File)
 	default:
 		return nil
 	}
+	if newHandler == nil {
+		return nil
+	}
+	parser.parseColorOutput(configuration, newHandler)
+	return newHandler
 }
 
-// parseLogger parses parser.LoggerConfiguration configuration and returns
-// structuredlogger.Logger.
-func (parser *Parser) parseLogger(configuration parser.LoggerConfiguration) *structuredlogger.Logger {
-	newLogger := structuredlogger.New(configuration.Name, configuration.TimeFormat)
+// parseColorOutput parses color output mode from parser.HandlerConfiguration
+// configuration and sets it to the handler, it panics if color output mode is
+// unknown.
+func (parser *Parser) parseColorOutput(configuration parser.HandlerConfiguration, newHandler *handler.Handler) {
+	output, err := configuration.ColorOutput()
+	if err != nil {
+		panic(err)
+	}
+	if configuration.Color != "" {
+		newHandler.SetColorOutput(output)
+	}
+}
+
+// parseLocation parses time zone from parser.LoggerConfiguration configuration
+// and returns time.Location, it panics if time zone is unknown.
+func (parser *Parser) parseLocation(configuration parser.LoggerConfiguration) *time.Location {
+	location, err := configuration.Location()
+	if err != nil {
+		panic(err)
+	}
+	return location
+}
+
+// parseMinimumLevel parses minimum level from parser.LoggerConfiguration
+// configuration, it panics if level is unknown.
+func (parser *Parser) parseMinimumLevel(configuration parser.LoggerConfiguration) level.Level {
+	logLevel, err := configuration.MinimumLevel()
+	if err != nil {
+		panic(err)
+	}
+	return logLevel
+}
+
+// parseStackLevel parses level starting from which stack of the goroutine is
+// captured from parser.LoggerConfiguration configuration, it panics if level is
+// unknown.
+func (parser *Parser) parseStackLevel(configuration parser.LoggerConfiguration) level.Level {
+	logLevel, err := configuration.StackCaptureLevel()
+	if err != nil {
+		panic(err)
+	}
+	return logLevel
+}
+
+// parseRedactor parses redaction configuration from parser.LoggerConfiguration
+// configuration and returns redaction.Redactor, it panics if any of the rules
+// is invalid.
+func (parser *Parser) parseRedactor(configuration parser.LoggerConfiguration) *redaction.Redactor {
+	redactor, err := configuration.Redaction.Redactor()
+	if err != nil {
+		panic(err)
+	}
+	return redactor
+}
+
+// configureLogger applies parser.LoggerConfiguration configuration to the
+// structuredlogger.Logger and registers configured handlers.
+func (parser *Parser) configureLogger(configuration parser.LoggerConfiguration, newLogger *structuredlogger.Logger) {
 	newLogger.SetErrorLevel(level.ParseLevel(strings.ToLower(configuration.ErrorLevel)))
 	newLogger.SetPanicLevel(level.ParseLevel(strings.ToLower(configuration.PanicLevel)))
+	newLogger.SetLocation(parser.parseLocation(configuration))
+	newLogger.SetStackLevel(parser.parseStackLevel(configuration))
+	newLogger.SetRedactor(parser.parseRedactor(configuration))
 	newLogger.SetRequestMapping(configuration.RequestMapping)
 	newLogger.SetResponseMapping(configuration.ResponseMapping)
+	newLogger.SetLevel(parser.parseMinimumLevel(configuration))
+	newLogger.SetPropagate(configuration.Propagates())
 	for _, handlerConfiguration := range configuration.Handlers {
 		newLogger.AddHandler(parser.parseHandler(handlerConfiguration))
 	}
+}
+
+// parseLogger parses parser.LoggerConfiguration configuration and returns
+// structuredlogger.Logger.
+func (parser *Parser) parseLogger(configuration parser.LoggerConfiguration) *structuredlogger.Logger {
+	newLogger := structuredlogger.New(configuration.Name, configuration.TimeFormat)
+	parser.configureLogger(configuration, newLogger)
 	return newLogger
 }
 
@@ -99,13 +222,7 @@ func (parser *Parser) parseLogger(configuration parser.LoggerConfiguration) *str
 // structuredlogger.AsyncLogger.
 func (parser *Parser) parseAsyncLogger(configuration parser.LoggerConfiguration) *structuredlogger.AsyncLogger {
 	newLogger := structuredlogger.NewAsyncLogger(configuration.Name, configuration.TimeFormat, configuration.MessageQueueSize)
-	newLogger.SetErrorLevel(level.ParseLevel(strings.ToLower(configuration.ErrorLevel)))
-	newLogger.SetPanicLevel(level.ParseLevel(strings.ToLower(configuration.PanicLevel)))
-	newLogger.SetRequestMapping(configuration.RequestMapping)
-	newLogger.SetResponseMapping(configuration.ResponseMapping)
-	for _, handlerConfiguration := range configuration.Handlers {
-		newLogger.AddHandler(parser.parseHandler(handlerConfiguration))
-	}
+	parser.configureLogger(configuration, newLogger.Logger)
 	return newLogger
 }
 
@@ -129,3 +246,18 @@ func (parser *Parser) GetAsyncLogger(name string) *structuredlogger.AsyncLogger
 	}
 	return nil
 }
+
+// Configure configures named loggers of the hierarchy (see structuredlogger.GetLogger)
+// using all logger configurations, e.g. configuration with name "app.db"
+// configures structuredlogger.GetLogger("app.db") and configuration with name
+// structuredlogger.RootName configures the default logger. Settings and handlers of the
+// configured loggers are replaced, time format is not applied.
+func (parser *Parser) Configure() {
+	for _, loggerConfiguration := range parser.configuration.Loggers {
+		namedLogger := structuredlogger.GetLogger(loggerConfiguration.Name)
+		for _, registeredHandler := range namedLogger.Handlers() {
+			namedLogger.RemoveHandler(registeredHandler)
+		}
+		parser.configureLogger(loggerConfiguration, namedLogger)
+	}
+}
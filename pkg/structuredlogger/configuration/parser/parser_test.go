@@ -4,7 +4,11 @@ import (
 	"fmt"
 	"github.com/dl1998/go-logging/internal/testutils"
 	"github.com/dl1998/go-logging/pkg/common/configuration/parser"
+	commonformatter "github.com/dl1998/go-logging/pkg/common/formatter"
 	"github.com/dl1998/go-logging/pkg/common/level"
+	"github.com/dl1998/go-logging/pkg/structuredlogger"
+	"github.com/dl1998/go-logging/pkg/structuredlogger/formatter"
+	"github.com/dl1998/go-logging/pkg/structuredlogger/handler"
 	"io"
 	"os"
 	"path"
@@ -245,6 +249,61 @@ func TestParser_ParseFormatter_KeyValue(t *testing.T) {
 	testutils.AssertEquals(t, template, formatter.Template())
 }
 
+// TestParser_ParseFormatter_Console tests that Parser.parseFormatter returns
+// formatter.ConsoleFormatter for the console type.
+func TestParser_ParseFormatter_Console(t *testing.T) {
+	configuration := parser.FormatterConfiguration{
+		Type: "console",
+		Template: parser.TemplateConfiguration{
+			MapValue: template,
+		},
+	}
+
+	newFormatter := testDataParser.parseFormatter(configuration)
+
+	_, ok := newFormatter.(*formatter.ConsoleFormatter)
+
+	testutils.AssertEquals(t, true, ok)
+	testutils.AssertEquals(t, template, newFormatter.Template())
+}
+
+// TestParser_ParseFormatter_Sanitize tests that Parser.parseFormatter sets
+// sanitization mode of the formatter and keeps default mode of the formatter,
+// if it is not configured.
+func TestParser_ParseFormatter_Sanitize(t *testing.T) {
+	configuration := parser.FormatterConfiguration{
+		Type:     "json",
+		Sanitize: "escape",
+		Template: parser.TemplateConfiguration{
+			MapValue: template,
+		},
+	}
+
+	testutils.AssertEquals(t, commonformatter.SanitizeEscape, commonformatter.SanitizeModeOf(testDataParser.parseFormatter(configuration)))
+
+	configuration.Sanitize = ""
+
+	testutils.AssertEquals(t, commonformatter.SanitizeNone, commonformatter.SanitizeModeOf(testDataParser.parseFormatter(configuration)))
+}
+
+// TestParser_ParseFormatter_Template tests that Parser.parseFormatter returns
+// formatter.TemplateFormatter for the template type.
+func TestParser_ParseFormatter_Template(t *testing.T) {
+	configuration := parser.FormatterConfiguration{
+		Type: "template",
+		Template: parser.TemplateConfiguration{
+			StringValue: "{{.Message}}",
+		},
+	}
+
+	newFormatter := testDataParser.parseFormatter(configuration)
+
+	templateFormatter, ok := newFormatter.(*formatter.TemplateFormatter)
+
+	testutils.AssertEquals(t, true, ok)
+	testutils.AssertEquals(t, "{{.Message}}", templateFormatter.Text())
+}
+
 // TestParser_ParseFormatter_Default tests that Parser.parseFormatter panics if
 // unknown formatter type was provided.
 func TestParser_ParseFormatter_Default(t *testing.T) {
@@ -306,6 +365,66 @@ func TestParser_ParseHandler_File(t *testing.T) {
 	testutils.AssertEquals(t, toLevel, handler.ToLevel())
 }
 
+// TestParser_ParseFormatter_ColorScheme tests that Parser.parseFormatter sets
+// configured color scheme to the formatter and panics for the invalid one.
+func TestParser_ParseFormatter_ColorScheme(t *testing.T) {
+	configuration := parser.FormatterConfiguration{
+		Type: "json",
+		Template: parser.TemplateConfiguration{
+			MapValue: template,
+		},
+		Colors: parser.ColorSchemeConfiguration{
+			Mode: "message",
+		},
+	}
+
+	colorable, ok := testDataParser.parseFormatter(configuration).(commonformatter.Colorable)
+
+	testutils.AssertEquals(t, true, ok)
+	testutils.AssertEquals(t, commonformatter.ColorMessage, colorable.ColorScheme().Mode)
+
+	defer func() {
+		testutils.AssertNotNil(t, recover())
+	}()
+
+	configuration.Colors.Mode = "unknown"
+
+	testDataParser.parseFormatter(configuration)
+}
+
+// TestParser_ParseHandler_Limits tests that Parser.parseHandler sets limits of
+// the handler to its formatter.
+func TestParser_ParseHandler_Limits(t *testing.T) {
+	configuration := createHandlerConfiguration("stdout", "")
+	configuration.Limits = parser.LimitsConfiguration{MaxValueLength: 10, MaxRecordSize: 100}
+
+	handler := testParser.parseHandler(configuration)
+
+	limitable, ok := handler.Formatter().(commonformatter.Limitable)
+
+	testutils.AssertEquals(t, true, ok)
+	testutils.AssertEquals(t, commonformatter.Limits{MaxValueLength: 10, MaxRecordSize: 100}, limitable.Limits())
+}
+
+// TestParser_ParseHandler_Color tests that Parser.parseHandler sets color
+// output mode of the handler and panics for the unknown one.
+func TestParser_ParseHandler_Color(t *testing.T) {
+	configuration := createHandlerConfiguration("stdout", "")
+	configuration.Color = "always"
+
+	newHandler := testParser.parseHandler(configuration)
+
+	testutils.AssertEquals(t, true, newHandler.(*handler.Handler).Colored())
+
+	defer func() {
+		testutils.AssertNotNil(t, recover())
+	}()
+
+	configuration.Color = "unknown"
+
+	testParser.parseHandler(configuration)
+}
+
 // TestParser_ParseHandler_File_Error tests that Parser.parseHandler panics if
 // empty string was provided for file handler.
 func TestParser_ParseHandler_File_Error(t *testing.T) {
@@ -334,6 +453,105 @@ func BenchmarkParser_ParseHandler(b *testing.B) {
 	}
 }
 
+// TestParser_ParseLocation tests that Parser.parseLocation returns location for
+// the time zone from the configuration.
+func TestParser_ParseLocation(t *testing.T) {
+	location := testParser.parseLocation(parser.LoggerConfiguration{TimeZone: "UTC"})
+
+	testutils.AssertEquals(t, time.UTC, location)
+}
+
+// TestParser_ParseLocation_Error tests that Parser.parseLocation panics for the
+// unknown time zone.
+func TestParser_ParseLocation_Error(t *testing.T) {
+	defer func() {
+		if recovery := recover(); recovery == nil {
+			t.Fatalf("parseLocation did not panic on unknown time zone")
+		}
+	}()
+
+	testParser.parseLocation(parser.LoggerConfiguration{TimeZone: "Unknown/Zone"})
+}
+
+// BenchmarkParser_ParseLocation benchmarks the Parser.parseLocation function.
+func BenchmarkParser_ParseLocation(b *testing.B) {
+	configuration := parser.LoggerConfiguration{TimeZone: "UTC"}
+
+	for index := 0; index < b.N; index++ {
+		testParser.parseLocation(configuration)
+	}
+}
+
+// TestParser_ParseMinimumLevel tests that Parser.parseMinimumLevel returns
+// minimum level from the configuration.
+func TestParser_ParseMinimumLevel(t *testing.T) {
+	testutils.AssertEquals(t, level.All, testParser.parseMinimumLevel(parser.LoggerConfiguration{}))
+	testutils.AssertEquals(t, level.Warning, testParser.parseMinimumLevel(parser.LoggerConfiguration{Level: "warning"}))
+}
+
+// TestParser_ParseMinimumLevel_Error tests that Parser.parseMinimumLevel panics
+// for the unknown level.
+func TestParser_ParseMinimumLevel_Error(t *testing.T) {
+	defer func() {
+		if recovery := recover(); recovery == nil {
+			t.Fatalf("parseMinimumLevel did not panic on unknown level")
+		}
+	}()
+
+	testParser.parseMinimumLevel(parser.LoggerConfiguration{Level: "warn"})
+}
+
+// TestParser_ParseStackLevel tests that Parser.parseStackLevel returns level
+// starting from which stack is captured from the configuration.
+func TestParser_ParseStackLevel(t *testing.T) {
+	testutils.AssertEquals(t, level.Null, testParser.parseStackLevel(parser.LoggerConfiguration{}))
+	testutils.AssertEquals(t, level.Error, testParser.parseStackLevel(parser.LoggerConfiguration{StackLevel: "ERROR"}))
+}
+
+// TestParser_ParseStackLevel_Error tests that Parser.parseStackLevel panics for
+// the unknown level.
+func TestParser_ParseStackLevel_Error(t *testing.T) {
+	defer func() {
+		if recovery := recover(); recovery == nil {
+			t.Fatalf("parseStackLevel did not panic on unknown level")
+		}
+	}()
+
+	testParser.parseStackLevel(parser.LoggerConfiguration{StackLevel: "eror"})
+}
+
+// TestParser_ParseRedactor tests that Parser.parseRedactor returns redactor
+// with rules from the configuration.
+func TestParser_ParseRedactor(t *testing.T) {
+	configuration := parser.LoggerConfiguration{
+		Redaction: parser.RedactionConfiguration{
+			Rules: []parser.RedactionRuleConfiguration{{Key: "password", Action: "drop"}},
+		},
+	}
+
+	redactor := testParser.parseRedactor(configuration)
+
+	testutils.AssertNotNil(t, redactor)
+	testutils.AssertEquals(t, 1, len(redactor.Rules()))
+	testutils.AssertNil(t, testParser.parseRedactor(parser.LoggerConfiguration{}))
+}
+
+// TestParser_ParseRedactor_Error tests that Parser.parseRedactor panics for the
+// invalid rule.
+func TestParser_ParseRedactor_Error(t *testing.T) {
+	defer func() {
+		if recovery := recover(); recovery == nil {
+			t.Fatalf("parseRedactor did not panic on invalid rule")
+		}
+	}()
+
+	testParser.parseRedactor(parser.LoggerConfiguration{
+		Redaction: parser.RedactionConfiguration{
+			Rules: []parser.RedactionRuleConfiguration{{Value: "("}},
+		},
+	})
+}
+
 // TestParser_ParseLogger tests that Parser.parseLogger returns logger.Logger.
 func TestParser_ParseLogger(t *testing.T) {
 	loggerTemplate := testDataParser.configuration.Loggers[0]
@@ -443,3 +661,45 @@ func BenchmarkParser_GetAsyncLogger(b *testing.B) {
 		_ = testParser.GetAsyncLogger(name)
 	}
 }
+
+// TestParser_Configure tests that Parser.Configure configures named loggers of
+// the hierarchy.
+func TestParser_Configure(t *testing.T) {
+	disabled := false
+	hierarchyParser := &Parser{
+		configuration: &parser.Configuration{
+			Loggers: []parser.LoggerConfiguration{
+				{
+					Name:  "parser.app",
+					Level: level.Info.String(),
+					Handlers: []parser.HandlerConfiguration{
+						createHandlerConfiguration("stdout", ""),
+					},
+				},
+				{
+					Name:      "parser.app.db",
+					Propagate: &disabled,
+				},
+			},
+		},
+	}
+
+	hierarchyParser.Configure()
+	hierarchyParser.Configure()
+
+	app := structuredlogger.GetLogger("parser.app")
+	database := structuredlogger.GetLogger("parser.app.db")
+
+	testutils.AssertEquals(t, 1, len(app.Handlers()))
+	testutils.AssertEquals(t, level.Info, app.Level())
+	testutils.AssertEquals(t, true, app.Propagate())
+	testutils.AssertEquals(t, level.Info, structuredlogger.GetLogger("parser.app.db.pool").EffectiveLevel())
+	testutils.AssertEquals(t, false, database.Propagate())
+}
+
+// BenchmarkParser_Configure benchmarks the Parser.Configure function.
+func BenchmarkParser_Configure(b *testing.B) {
+	for index := 0; index < b.N; index++ {
+		testParser.Configure()
+	}
+}
@@ -3,36 +3,105 @@ package formatter
 
 import (
 	"encoding/json"
+	"fmt"
 	commonFormatter "github.com/dl1998/go-logging/pkg/common/formatter"
 	"github.com/dl1998/go-logging/pkg/common/level"
+	commonLogRecord "github.com/dl1998/go-logging/pkg/common/logrecord"
 	"github.com/dl1998/go-logging/pkg/structuredlogger/logrecord"
+	"maps"
 	"sort"
 	"strconv"
 	"strings"
+	textTemplate "text/template"
 )
 
-// logLevelColors maps level.Level values to ANSI color codes.
-var logLevelColors = map[level.Level]string{
-	level.Trace:     "\033[90m",         // Dark Grey
-	level.Debug:     "\033[36m",         // Cyan
-	level.Verbose:   "\033[96m",         // Light Cyan
-	level.Info:      "\033[97m",         // Default terminal text color (ANSI Bright White)
-	level.Notice:    "\033[94m",         // Light Blue
-	level.Warning:   "\033[93m",         // Bright Yellow
-	level.Severe:    "\033[38;5;208m",   // Orange
-	level.Error:     "\033[31m",         // Red
-	level.Alert:     "\033[38;5;202m",   // Dark Orange
-	level.Critical:  "\033[1;31m",       // Red or magenta (ANSI Bright Magenta)
-	level.Emergency: "\033[97m\033[41m", // Bright White on Red Background
-}
+// stackKey is a key of the goroutine stack in the formatted log record, stack
+// is formatted as array of frames by the JSONFormatter and as string by other
+// formatters.
+const stackKey = "stack"
 
-// Reset color
-const resetColor = "\033[0m"
+// truncatedKey is a key of the flag in the formatted log record, that is set
+// if any of the values was truncated according to the limits.
+const truncatedKey = "truncated"
 
 // baseFormatter struct that contains necessary for the formatting fields.
 type baseFormatter struct {
+	// Sanitization contains sanitization mode of the parameters.
+	commonFormatter.Sanitization
+	// Limitation contains limits of the parameters and formatted log records.
+	commonFormatter.Limitation
+	// Coloring contains color scheme of the colored output.
+	commonFormatter.Coloring
 	// template contains key-value pairs with template for the formatter.
 	template map[string]string
+	// compiled contains placeholder segments of the template values, values
+	// that are not placeholders are written as literals.
+	compiled map[string]commonFormatter.Segment
+	// usesCaller defines whether template uses caller information.
+	usesCaller bool
+	// usesGoroutine defines whether template uses identifier of the goroutine.
+	usesGoroutine bool
+}
+
+// newBaseFormatter create a new instance of the baseFormatter. Values of the
+// template are compiled once, value is a placeholder if it consists of a
+// single placeholder, e.g. "%(level:>7)". It panics if template contains
+// invalid format specifier.
+func newBaseFormatter(template map[string]string) *baseFormatter {
+	usesCaller := false
+	usesGoroutine := false
+	compiled := make(map[string]commonFormatter.Segment, len(template))
+	for key, value := range template {
+		compiledValue, err := commonFormatter.CompileTemplate(value)
+		if err != nil {
+			panic(err)
+		}
+		segments := compiledValue.Segments()
+		if len(segments) != 1 || !segments[0].IsPlaceholder() {
+			continue
+		}
+		compiled[key] = segments[0]
+		usesCaller = usesCaller || commonFormatter.PlaceholderUsesCaller(segments[0].Key)
+		usesGoroutine = usesGoroutine || segments[0].Key == commonFormatter.GoroutinePlaceholder
+	}
+	return &baseFormatter{
+		template:      template,
+		compiled:      compiled,
+		usesCaller:    usesCaller,
+		usesGoroutine: usesGoroutine,
+	}
+}
+
+// UsesCaller returns true, if template uses caller information of the log
+// record, e.g. "%(fname)" or "%(fline)". Values of the parameters are never
+// interpreted as placeholders.
+func (formatter *baseFormatter) UsesCaller() bool {
+	return formatter.usesCaller
+}
+
+// UsesGoroutine returns true, if template uses identifier of the goroutine.
+func (formatter *baseFormatter) UsesGoroutine() bool {
+	return formatter.usesGoroutine
+}
+
+// highlighted returns true, if value of the key shall be colored in the color
+// mode: level in the ColorLevel mode, message in the ColorMessage mode.
+func (formatter *baseFormatter) highlighted(key string, mode commonFormatter.ColorMode) bool {
+	switch mode {
+	case commonFormatter.ColorLevel:
+		return formatter.template[key] == "%(level)"
+	case commonFormatter.ColorMessage:
+		return key == "message"
+	}
+	return false
+}
+
+// colorLines wraps every line of the text into the color.
+func colorLines(text string, color commonFormatter.Color) string {
+	if color == "" {
+		return text
+	}
+	return color.Wrap(strings.ReplaceAll(text, "\n", commonFormatter.ColorReset+"\n"+string(color)))
 }
 
 // Template returns template string used by formatter.
@@ -42,23 +111,111 @@ func (formatter *baseFormatter) Template() map[string]string {
 
 // Format formats provided message template to the interpolated string.
 func (formatter *baseFormatter) Format(record logrecord.Interface) map[string]interface{} {
+	record = SanitizeRecord(record, formatter.SanitizeMode())
+	record, truncated := LimitRecord(record, formatter.Limits())
+
 	format := make(map[string]interface{})
 
 	for key, value := range formatter.template {
-		format[key] = commonFormatter.ParseKey(value, record)
+		format[key] = formatter.templateValue(key, value, record)
 	}
 
 	for key, value := range record.Parameters() {
-		if stringValue, ok := value.(string); ok {
-			format[key] = commonFormatter.ParseKey(stringValue, record)
-		} else {
-			format[key] = value
-		}
+		format[key] = value
+	}
+
+	if stack := record.Stack(); len(stack) > 0 {
+		format[stackKey] = stack
+	}
+
+	if truncated {
+		format[truncatedKey] = true
 	}
 
 	return format
 }
 
+// templateValue returns value of the template key from the log record. Values
+// that are not placeholders and unknown placeholders are returned as is.
+func (formatter *baseFormatter) templateValue(key string, value string, record logrecord.Interface) interface{} {
+	segment, ok := formatter.compiled[key]
+	if !ok {
+		return value
+	}
+	resolved, ok := commonFormatter.SegmentValue(segment, record)
+	if !ok {
+		return value
+	}
+	if segment.Specifier != nil {
+		return segment.Specifier.Format(resolved)
+	}
+	return resolved
+}
+
+// sanitizedRecord is a log record with sanitized or truncated parameters.
+type sanitizedRecord struct {
+	logrecord.Interface
+	// parameters are sanitized or truncated parameters of the log record.
+	parameters map[string]interface{}
+}
+
+// Parameters returns sanitized or truncated parameters of the log record.
+func (record *sanitizedRecord) Parameters() map[string]interface{} {
+	return record.parameters
+}
+
+// SanitizeRecord returns log record with string values of the parameters
+// sanitized according to the mode. Record is returned as is, if parameters do
+// not need sanitization.
+func SanitizeRecord(record logrecord.Interface, mode commonFormatter.SanitizeMode) logrecord.Interface {
+	if mode <= commonFormatter.SanitizeNone {
+		return record
+	}
+	var parameters map[string]interface{}
+	for key, value := range record.Parameters() {
+		stringValue, ok := value.(string)
+		if !ok {
+			continue
+		}
+		sanitized := commonFormatter.Sanitize(stringValue, mode)
+		if sanitized == stringValue {
+			continue
+		}
+		if parameters == nil {
+			parameters = maps.Clone(record.Parameters())
+		}
+		parameters[key] = sanitized
+	}
+	if parameters == nil {
+		return record
+	}
+	return &sanitizedRecord{Interface: record, parameters: parameters}
+}
+
+// LimitRecord returns log record with parameters cut according to the limits.
+// It returns true, if any of the parameters was truncated, otherwise record is
+// returned as is.
+func LimitRecord(record logrecord.Interface, limits commonFormatter.Limits) (logrecord.Interface, bool) {
+	if limits.MaxValueLength <= 0 && limits.MaxCollectionElements <= 0 && limits.MaxDepth <= 0 {
+		return record, false
+	}
+	var parameters map[string]interface{}
+	for key, value := range record.Parameters() {
+		limited, truncated := limits.LimitValue(value)
+		if !truncated {
+			continue
+		}
+		if parameters == nil {
+			parameters = maps.Clone(record.Parameters())
+		}
+		parameters[key] = limited
+	}
+	if parameters == nil {
+		return record, false
+	}
+	return &sanitizedRecord{Interface: record, parameters: parameters}, true
+}
+
 // Interface represents interface that shall be satisfied by Formatter.
 type Interface interface {
 	Template() map[string]string
@@ -71,44 +228,105 @@ type JSONFormatter struct {
 	pretty bool
 }
 
-// NewJSON create a new instance of the JSONFormatter.
+// NewJSON create a new instance of the JSONFormatter. Values are not sanitized
+// by default, because JSON encoding already escapes control characters.
 func NewJSON(template map[string]string, pretty bool) *JSONFormatter {
-	return &JSONFormatter{
-		baseFormatter: &baseFormatter{
-			template: template,
-		},
-		pretty: pretty,
+	newFormatter := &JSONFormatter{
+		baseFormatter: newBaseFormatter(template),
+		pretty:        pretty,
 	}
+	newFormatter.SetSanitizeMode(commonFormatter.SanitizeNone)
+	return newFormatter
 }
 
 // Format formats provided message template to the interpolated string.
 func (formatter *JSONFormatter) Format(record logrecord.Interface, colored bool) string {
 	var format = formatter.baseFormatter.Format(record)
 
-	var data []byte
-	var err error
-
-	if formatter.pretty {
-		data, err = json.MarshalIndent(format, "", "  ")
-	} else {
-		data, err = json.Marshal(format)
-	}
+	data, err := formatter.encode(format)
 
 	if err != nil {
 		return ""
 	}
 
+	if size := formatter.Limits().MaxRecordSize; size > 0 && len(data) > size {
+		data = formatter.fit(format, data, size)
+	}
+
 	formattedString := string(data)
 
-	if colored && !formatter.pretty {
-		formattedString = logLevelColors[record.Level()] + formattedString + resetColor
-	} else if colored && formatter.pretty {
-		formattedString = logLevelColors[record.Level()] + strings.ReplaceAll(formattedString, "\n", resetColor+"\n"+logLevelColors[record.Level()]) + resetColor
+	if colored {
+		formattedString = colorLines(formattedString, formatter.ColorScheme().Level(record.Level()))
 	}
 
 	return formattedString + "\n"
 }
 
+// fit replaces values of the formatted log record with the truncation markers
+// starting from the largest one, until encoded record does not exceed size.
+// Parameters are replaced first, values of the template are replaced only if
+// parameters alone do not fit. If record still exceeds size, encoded record is
+// cut by Limits.FitRecord. It returns the last encoded record.
+func (formatter *JSONFormatter) fit(format map[string]interface{}, data []byte, size int) []byte {
+	sizes := make(map[string]int, len(format))
+	parameterKeys := make([]string, 0, len(format))
+	templateKeys := make([]string, 0, len(formatter.template))
+	for key, value := range format {
+		if key == truncatedKey {
+			continue
+		}
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			continue
+		}
+		sizes[key] = len(encoded)
+		if _, ok := formatter.template[key]; ok {
+			templateKeys = append(templateKeys, key)
+		} else {
+			parameterKeys = append(parameterKeys, key)
+		}
+	}
+	bySize := func(keys []string) []string {
+		sort.Slice(keys, func(i, j int) bool {
+			return sizes[keys[i]] > sizes[keys[j]]
+		})
+		return keys
+	}
+
+	format[truncatedKey] = true
+
+	for _, key := range append(bySize(parameterKeys), bySize(templateKeys)...) {
+		if len(data) <= size {
+			break
+		}
+		marker := commonFormatter.TruncatedBytes(sizes[key])
+		if _, ok := formatter.template[key]; ok && len(marker)+2 >= sizes[key] {
+			continue
+		}
+		format[key] = marker
+		encoded, err := formatter.encode(format)
+		if err != nil {
+			break
+		}
+		data = encoded
+	}
+
+	if len(data) > size {
+		fitted, _ := formatter.Limits().FitRecord(string(data))
+		data = []byte(fitted)
+	}
+
+	return data
+}
+
+// encode encodes formatted log record to JSON.
+func (formatter *JSONFormatter) encode(format map[string]interface{}) ([]byte, error) {
+	if formatter.pretty {
+		return json.MarshalIndent(format, "", "  ")
+	}
+	return json.Marshal(format)
+}
+
 // KeyValueFormatter struct that contains necessary for the formatting fields.
 type KeyValueFormatter struct {
 	// baseFormatter is a base formatter.
@@ -122,9 +340,7 @@ type KeyValueFormatter struct {
 // NewKeyValue create a new instance of the KeyValueFormatter.
 func NewKeyValue(template map[string]string, keyValueDelimiter string, pairSeparator string) *KeyValueFormatter {
 	return &KeyValueFormatter{
-		baseFormatter: &baseFormatter{
-			template: template,
-		},
+		baseFormatter:     newBaseFormatter(template),
 		keyValueDelimiter: keyValueDelimiter,
 		pairSeparator:     pairSeparator,
 	}
@@ -134,6 +350,12 @@ func NewKeyValue(template map[string]string, keyValueDelimiter string, pairSepar
 func (formatter *KeyValueFormatter) Format(record logrecord.Interface, colored bool) string {
 	var format = formatter.baseFormatter.Format(record)
 
+	scheme := formatter.ColorScheme()
+	mode := commonFormatter.ColorDefault
+	if colored {
+		mode = scheme.ModeOr(commonFormatter.ColorLine)
+	}
+
 	var result strings.Builder
 
 	var keys = make([]string, 0, len(format))
@@ -145,36 +367,298 @@ func (formatter *KeyValueFormatter) Format(record logrecord.Interface, colored b
 	sort.Strings(keys)
 
 	for _, key := range keys {
-		value := format[key]
-		result.WriteString(key)
+		value := keyValueString(format[key])
+		if mode == commonFormatter.ColorLevel || mode == commonFormatter.ColorMessage {
+			result.WriteString(scheme.Key.Wrap(key))
+		} else {
+			result.WriteString(key)
+		}
 		result.WriteString(formatter.keyValueDelimiter)
-		switch convertedValue := value.(type) {
-		case string:
-			result.WriteString("\"")
-			result.WriteString(convertedValue)
-			result.WriteString("\"")
-		case bool:
-			result.WriteString(strconv.FormatBool(convertedValue))
-		case int:
-			result.WriteString(strconv.Itoa(convertedValue))
-		case int64:
-			result.WriteString(strconv.FormatInt(convertedValue, 10))
-		case float64:
-			result.WriteString(strconv.FormatFloat(convertedValue, 'f', -1, 64))
-		case float32:
-			result.WriteString(strconv.FormatFloat(float64(convertedValue), 'f', -1, 32))
+		if formatter.highlighted(key, mode) {
+			value = scheme.Colorize(record.Level(), value)
 		}
+		result.WriteString(value)
 		result.WriteString(formatter.pairSeparator)
 	}
 
 	formattedString := strings.TrimSuffix(result.String(), formatter.pairSeparator)
+	formattedString, _ = formatter.Limits().FitRecord(formattedString)
+
+	if mode == commonFormatter.ColorLine {
+		formattedString = colorLines(formattedString, scheme.Level(record.Level()))
+	}
+
+	return formattedString + "\n"
+}
+
+// keyValueString converts value to the string representation used by the
+// KeyValueFormatter, strings are quoted. It returns empty string for the
+// unsupported types.
+func keyValueString(value interface{}) string {
+	switch convertedValue := value.(type) {
+	case string:
+		return "\"" + convertedValue + "\""
+	case bool:
+		return strconv.FormatBool(convertedValue)
+	case int:
+		return strconv.Itoa(convertedValue)
+	case int64:
+		return strconv.FormatInt(convertedValue, 10)
+	case float64:
+		return strconv.FormatFloat(convertedValue, 'f', -1, 64)
+	case float32:
+		return strconv.FormatFloat(float64(convertedValue), 'f', -1, 32)
+	case commonLogRecord.Stack:
+		return strconv.Quote(convertedValue.String())
+	}
+	return ""
+}
+
+// consoleTimeFormat is a short time format used by the ConsoleFormatter.
+const consoleTimeFormat = "15:04:05"
+
+// consoleLevelWidth is a width of the level badge, it is equal to the length of
+// the longest level name.
+const consoleLevelWidth = 9
+
+// consoleIndent is an indentation used for the values printed on the following
+// lines.
+const consoleIndent = "    "
+
+// consoleHeaderValues contains placeholders that are already printed in the
+// header of the console line, so template values with these placeholders (with
+// or without format specifier) are skipped in the list of parameters.
+var consoleHeaderValues = map[string]bool{
+	"name":      true,
+	"level":     true,
+	"datetime":  true,
+	"timestamp": true,
+}
+
+// ConsoleFormatter struct that contains necessary for the formatting fields. It
+// formats log record in the human-friendly form for the local development.
+type ConsoleFormatter struct {
+	// baseFormatter is a base formatter.
+	*baseFormatter
+}
+
+// NewConsole create a new instance of the ConsoleFormatter. Template values are
+// printed after the message as additional parameters.
+func NewConsole(template map[string]string) *ConsoleFormatter {
+	return &ConsoleFormatter{
+		baseFormatter: newBaseFormatter(template),
+	}
+}
 
+// Format formats provided log record to the human-friendly string. It prints
+// short timestamp, level badge, logger name, message and parameters as
+// key=value pairs. Errors and multi-line values are printed on the following
+// lines.
+func (formatter *ConsoleFormatter) Format(record logrecord.Interface, colored bool) string {
+	var format = formatter.baseFormatter.Format(record)
+
+	for key, value := range formatter.template {
+		if name, _, ok := commonFormatter.ParsePlaceholder(value); ok && consoleHeaderValues[name] {
+			delete(format, key)
+		}
+	}
+
+	scheme := formatter.ColorScheme()
+	mode := commonFormatter.ColorDefault
 	if colored {
-		formattedString = logLevelColors[record.Level()] + formattedString + resetColor
-		if formatter.pairSeparator == "\n" {
-			formattedString = strings.ReplaceAll(formattedString, "\n", resetColor+"\n"+logLevelColors[record.Level()])
+		mode = scheme.ModeOr(commonFormatter.ColorLevel)
+	}
+
+	var result strings.Builder
+
+	result.WriteString(record.RawTime().Format(consoleTimeFormat))
+	result.WriteString(" ")
+
+	badge := fmt.Sprintf("%-*s", consoleLevelWidth, strings.ToUpper(record.Level().String()))
+	if mode == commonFormatter.ColorLevel {
+		badge = scheme.Colorize(record.Level(), badge)
+	}
+	result.WriteString(badge)
+	result.WriteString(" ")
+	result.WriteString(record.Name())
+
+	if message, ok := format["message"]; ok {
+		delete(format, "message")
+		result.WriteString("  ")
+		if mode == commonFormatter.ColorMessage {
+			result.WriteString(scheme.Colorize(record.Level(), fmt.Sprintf("%v", message)))
+		} else {
+			result.WriteString(fmt.Sprintf("%v", message))
+		}
+	}
+
+	var keys = make([]string, 0, len(format))
+
+	for key := range format {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	var trailing strings.Builder
+
+	for _, key := range keys {
+		value := format[key]
+		if text, ok := consoleBlockValue(value); ok {
+			trailing.WriteString("\n")
+			trailing.WriteString(consoleIndent)
+			trailing.WriteString(formatter.colorKey(key+":", mode))
+			lines := strings.Split(strings.TrimRight(text, "\n"), "\n")
+			if len(lines) == 1 {
+				trailing.WriteString(" ")
+				trailing.WriteString(lines[0])
+				continue
+			}
+			for _, line := range lines {
+				trailing.WriteString("\n")
+				trailing.WriteString(consoleIndent + consoleIndent)
+				trailing.WriteString(line)
+			}
+			continue
+		}
+		result.WriteString(" ")
+		result.WriteString(formatter.colorKey(key+"=", mode))
+		result.WriteString(consoleInlineValue(value))
+	}
+
+	result.WriteString(trailing.String())
+
+	formattedString, _ := formatter.Limits().FitRecord(result.String())
+
+	if mode == commonFormatter.ColorLine {
+		formattedString = colorLines(formattedString, scheme.Level(record.Level()))
+	}
+
+	return formattedString + "\n"
+}
+
+// colorKey wraps key into the key color of the color scheme, if only level or
+// message is colored.
+func (formatter *ConsoleFormatter) colorKey(key string, mode commonFormatter.ColorMode) string {
+	if mode == commonFormatter.ColorLevel || mode == commonFormatter.ColorMessage {
+		return formatter.ColorScheme().Key.Wrap(key)
+	}
+	return key
+}
+
+// consoleBlockValue returns text of the value and true, if value shall be
+// printed on the following lines (errors and multi-line strings).
+func consoleBlockValue(value interface{}) (string, bool) {
+	switch convertedValue := value.(type) {
+	case error:
+		return convertedValue.Error(), true
+	case commonLogRecord.Stack:
+		return convertedValue.String(), true
+	case string:
+		if strings.Contains(convertedValue, "\n") {
+			return convertedValue, true
 		}
 	}
+	return "", false
+}
+
+// consoleInlineValue converts value to the string representation used in the
+// key=value pairs, strings that contain spaces are quoted.
+func consoleInlineValue(value interface{}) string {
+	if stringValue, ok := value.(string); ok {
+		if stringValue == "" || strings.ContainsAny(stringValue, " \t\"=") {
+			return strconv.Quote(stringValue)
+		}
+		return stringValue
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// TemplateFormatter struct that contains necessary for the formatting with Go
+// text/template fields.
+type TemplateFormatter struct {
+	// Sanitization contains sanitization mode of the parameters.
+	commonFormatter.Sanitization
+	// Limitation contains limits of the parameters and formatted log records.
+	commonFormatter.Limitation
+	// Coloring contains color scheme of the colored output.
+	commonFormatter.Coloring
+	// text is a text/template string used by formatter.
+	text string
+	// plain is a parsed template used for the non-colored output.
+	plain *textTemplate.Template
+	// colored is a parsed template used for the colored output.
+	colored *textTemplate.Template
+	// usesCaller is true, if template references caller information.
+	usesCaller bool
+	// usesGoroutine is true, if template references identifier of the
+	// goroutine.
+	usesGoroutine bool
+}
+
+// NewTemplate create a new instance of the TemplateFormatter. It panics if
+// template could not be parsed.
+func NewTemplate(text string) *TemplateFormatter {
+	newFormatter := &TemplateFormatter{text: text}
+	newFormatter.plain = textTemplate.Must(textTemplate.New("plain").Funcs(commonFormatter.TemplateFunctions(func(_ level.Level, text string) string {
+		return text
+	})).Parse(text))
+	newFormatter.colored = textTemplate.Must(textTemplate.New("colored").Funcs(commonFormatter.TemplateFunctions(func(logLevel level.Level, text string) string {
+		return newFormatter.ColorScheme().Colorize(logLevel, text)
+	})).Parse(text))
+	newFormatter.usesCaller = commonFormatter.TemplateUsesField(newFormatter.plain, "Caller")
+	newFormatter.usesGoroutine = commonFormatter.TemplateUsesField(newFormatter.plain, "Goroutine")
+	return newFormatter
+}
+
+// Template returns nil, TemplateFormatter does not use key-value template.
+func (formatter *TemplateFormatter) Template() map[string]string {
+	return nil
+}
+
+// Text returns text/template string used by formatter.
+func (formatter *TemplateFormatter) Text() string {
+	return formatter.text
+}
+
+// UsesCaller returns true, if template references caller information of the
+// log record (.Caller) or passes the whole record, e.g. {{json .}}.
+func (formatter *TemplateFormatter) UsesCaller() bool {
+	return formatter.usesCaller
+}
+
+// UsesGoroutine returns true, if template references identifier of the
+// goroutine (.Goroutine) or passes the whole record, e.g. {{json .}}.
+func (formatter *TemplateFormatter) UsesGoroutine() bool {
+	return formatter.usesGoroutine
+}
+
+// Format executes template for the provided log record. Parameter "message" is
+// exposed as Message, all parameters (including message) are exposed as
+// Parameters. If template could not be executed, partial output is followed by
+// the error marker (see commonFormatter.TemplateError).
+func (formatter *TemplateFormatter) Format(record logrecord.Interface, colored bool) string {
+	record = SanitizeRecord(record, formatter.SanitizeMode())
+	record, _ = LimitRecord(record, formatter.Limits())
+
+	var result strings.Builder
+
+	data := commonFormatter.NewTemplateRecord(record)
+	data.Parameters = record.Parameters()
+	if message, ok := data.Parameters["message"]; ok {
+		data.Message = fmt.Sprint(message)
+	}
+
+	parsed := formatter.plain
+	if colored {
+		parsed = formatter.colored
+	}
+
+	if err := parsed.Execute(&result, data); err != nil {
+		result.WriteString(commonFormatter.TemplateError(err))
+	}
+
+	formattedString, _ := formatter.Limits().FitRecord(result.String())
 
 	return formattedString + "\n"
 }
@@ -2,12 +2,18 @@
 package formatter
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/dl1998/go-logging/internal/testutils"
+	commonFormatter "github.com/dl1998/go-logging/pkg/common/formatter"
 	"github.com/dl1998/go-logging/pkg/common/level"
+	commonLogRecord "github.com/dl1998/go-logging/pkg/common/logrecord"
 	"github.com/dl1998/go-logging/pkg/structuredlogger/logrecord"
 	"math"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 )
 
 const (
@@ -35,6 +41,54 @@ func TestNewJSON(t *testing.T) {
 	testutils.AssertEquals(t, pretty, newFormatter.pretty)
 }
 
+// TestBaseFormatter_UsesCaller tests that baseFormatter.UsesCaller reports
+// whether template uses caller information.
+func TestBaseFormatter_UsesCaller(t *testing.T) {
+	testutils.AssertEquals(t, false, NewJSON(template, pretty).UsesCaller())
+	testutils.AssertEquals(t, true, NewJSON(map[string]string{"line": "%(fline)"}, pretty).UsesCaller())
+}
+
+// TestBaseFormatter_UsesGoroutine tests that baseFormatter.UsesGoroutine
+// reports whether template uses identifier of the goroutine.
+func TestBaseFormatter_UsesGoroutine(t *testing.T) {
+	testutils.AssertEquals(t, false, NewJSON(template, pretty).UsesGoroutine())
+	testutils.AssertEquals(t, true, NewJSON(map[string]string{"goroutine": "%(goroutine)"}, pretty).UsesGoroutine())
+}
+
+// TestNewJSON_InvalidSpecifier tests that NewJSON panics if template contains
+// invalid format specifier.
+func TestNewJSON_InvalidSpecifier(t *testing.T) {
+	defer func() {
+		if recovery := recover(); recovery == nil {
+			t.Fatalf("NewJSON did not panic on invalid format specifier")
+		}
+	}()
+
+	NewJSON(map[string]string{"level": "%(level:8z)"}, pretty)
+}
+
+// TestJSONFormatter_Format_Specifier tests that JSONFormatter.Format applies
+// format specifier to the template values.
+func TestJSONFormatter_Format_Specifier(t *testing.T) {
+	newFormatter := NewJSON(map[string]string{"level": "%(level:>7)"}, pretty)
+
+	record := logrecord.New(loggerName, loggingLevel, "", map[string]interface{}{}, skipCallers)
+
+	testutils.AssertEquals(t, "{\"level\":\"  debug\"}\n", newFormatter.Format(record, false))
+}
+
+// TestJSONFormatter_Format_ParameterPlaceholders tests that JSONFormatter.Format
+// writes values of the parameters as is, without expansion of placeholders.
+func TestJSONFormatter_Format_ParameterPlaceholders(t *testing.T) {
+	newFormatter := NewJSON(map[string]string{"level": "%(level)", "literal": "level %(level)"}, pretty)
+
+	parameters := map[string]interface{}{"padding": "%(level:^200000000)", "stack": "%(stack)"}
+	record := logrecord.New(loggerName, loggingLevel, "", parameters, skipCallers)
+
+	expected := "{\"level\":\"debug\",\"literal\":\"level %(level)\",\"padding\":\"%(level:^200000000)\",\"stack\":\"%(stack)\"}\n"
+	testutils.AssertEquals(t, expected, newFormatter.Format(record, false))
+}
+
 // BenchmarkNewJSON performs benchmarking of the NewJSON().
 func BenchmarkNewJSON(b *testing.B) {
 	for index := 0; index < b.N; index++ {
@@ -42,9 +96,27 @@ func BenchmarkNewJSON(b *testing.B) {
 	}
 }
 
+// TestJSONFormatter_Format_Elapsed tests that JSONFormatter.Format keeps
+// relative and delta time as numeric fields.
+func TestJSONFormatter_Format_Elapsed(t *testing.T) {
+	newFormatter := NewJSON(map[string]string{"relative": "%(relative)", "delta": "%(delta)"}, pretty)
+
+	record := logrecord.New(loggerName, loggingLevel, "", map[string]interface{}{}, skipCallers, commonLogRecord.WithClock(commonLogRecord.NewClock()))
+
+	expected := fmt.Sprintf("{\"delta\":%s,\"relative\":%s}\n", formatMilliseconds(record.Delta()), formatMilliseconds(record.Relative()))
+
+	testutils.AssertEquals(t, expected, newFormatter.Format(record, false))
+}
+
+// formatMilliseconds formats duration as a JSON number of milliseconds.
+func formatMilliseconds(duration time.Duration) string {
+	number, _ := json.Marshal(float64(duration) / float64(time.Millisecond))
+	return string(number)
+}
+
 // TestJSONFormatter_Format tests that JSONFormatter.Format correctly formats string.
 func TestJSONFormatter_Format(t *testing.T) {
-	color := logLevelColors[loggingLevel]
+	color := commonFormatter.DefaultColorScheme().Level(loggingLevel)
 	tests := map[string]struct {
 		template map[string]string
 		pretty   bool
@@ -61,7 +133,7 @@ func TestJSONFormatter_Format(t *testing.T) {
 			template: template,
 			pretty:   false,
 			colored:  true,
-			expected: fmt.Sprintf("%s{\"level\":\"%s\",\"message\":\"%s\",\"name\":\"%s\",\"static\":\"%s\"}%s\n", color, loggingLevel.String(), message, loggerName, static, resetColor),
+			expected: fmt.Sprintf("%s{\"level\":\"%s\",\"message\":\"%s\",\"name\":\"%s\",\"static\":\"%s\"}%s\n", color, loggingLevel.String(), message, loggerName, static, commonFormatter.ColorReset),
 		},
 		"Pretty JSON Not Colored": {
 			template: template,
@@ -73,7 +145,7 @@ func TestJSONFormatter_Format(t *testing.T) {
 			template: template,
 			pretty:   true,
 			colored:  true,
-			expected: fmt.Sprintf("%s{%s\n%s  \"level\": \"%s\",%s\n%s  \"message\": \"%s\",%s\n%s  \"name\": \"%s\",%s\n%s  \"static\": \"%s\"%s\n%s}%s\n", color, resetColor, color, loggingLevel.String(), resetColor, color, message, resetColor, color, loggerName, resetColor, color, static, resetColor, color, resetColor),
+			expected: fmt.Sprintf("%s{%s\n%s  \"level\": \"%s\",%s\n%s  \"message\": \"%s\",%s\n%s  \"name\": \"%s\",%s\n%s  \"static\": \"%s\"%s\n%s}%s\n", color, commonFormatter.ColorReset, color, loggingLevel.String(), commonFormatter.ColorReset, color, message, commonFormatter.ColorReset, color, loggerName, commonFormatter.ColorReset, color, static, commonFormatter.ColorReset, color, commonFormatter.ColorReset),
 		},
 	}
 
@@ -176,7 +248,7 @@ func TestKeyValueFormatter_Format(t *testing.T) {
 	int64Value := int64(1)
 	float64Value := 1.0
 	float32Value := float32(1.0)
-	color := logLevelColors[loggingLevel]
+	color := commonFormatter.DefaultColorScheme().Level(loggingLevel)
 	tests := map[string]struct {
 		template  map[string]string
 		delimiter string
@@ -196,14 +268,14 @@ func TestKeyValueFormatter_Format(t *testing.T) {
 			delimiter: keyValueDelimiter,
 			separator: pairSeparator,
 			colored:   true,
-			expected:  fmt.Sprintf("%sbool=%t%sfloat32=%g%sfloat64=%g%sint=%d%sint64=%d%slevel=%q%smessage=%q%sname=%q%sstatic=%q%s\n", color, boolValue, pairSeparator, float32Value, pairSeparator, float64Value, pairSeparator, intValue, pairSeparator, int64Value, pairSeparator, loggingLevel.String(), pairSeparator, message, pairSeparator, loggerName, pairSeparator, static, resetColor),
+			expected:  fmt.Sprintf("%sbool=%t%sfloat32=%g%sfloat64=%g%sint=%d%sint64=%d%slevel=%q%smessage=%q%sname=%q%sstatic=%q%s\n", color, boolValue, pairSeparator, float32Value, pairSeparator, float64Value, pairSeparator, intValue, pairSeparator, int64Value, pairSeparator, loggingLevel.String(), pairSeparator, message, pairSeparator, loggerName, pairSeparator, static, commonFormatter.ColorReset),
 		},
 		"Key Value Colored New Line Pair Separator": {
 			template:  template,
 			delimiter: keyValueDelimiter,
 			separator: "\n",
 			colored:   true,
-			expected:  fmt.Sprintf("%sbool=%t%s\n%sfloat32=%g%s\n%sfloat64=%g%s\n%sint=%d%s\n%sint64=%d%s\n%slevel=%q%s\n%smessage=%q%s\n%sname=%q%s\n%sstatic=%q%s\n", color, boolValue, resetColor, color, float32Value, resetColor, color, float64Value, resetColor, color, intValue, resetColor, color, int64Value, resetColor, color, loggingLevel.String(), resetColor, color, message, resetColor, color, loggerName, resetColor, color, static, resetColor),
+			expected:  fmt.Sprintf("%sbool=%t%s\n%sfloat32=%g%s\n%sfloat64=%g%s\n%sint=%d%s\n%sint64=%d%s\n%slevel=%q%s\n%smessage=%q%s\n%sname=%q%s\n%sstatic=%q%s\n", color, boolValue, commonFormatter.ColorReset, color, float32Value, commonFormatter.ColorReset, color, float64Value, commonFormatter.ColorReset, color, intValue, commonFormatter.ColorReset, color, int64Value, commonFormatter.ColorReset, color, loggingLevel.String(), commonFormatter.ColorReset, color, message, commonFormatter.ColorReset, color, loggerName, commonFormatter.ColorReset, color, static, commonFormatter.ColorReset),
 		},
 	}
 
@@ -274,3 +346,399 @@ func BenchmarkKeyValueFormatter_Template(b *testing.B) {
 		newFormatter.Template()
 	}
 }
+
+// TestKeyValueFormatter_Format_Stack tests that KeyValueFormatter.Format
+// formats stack as a quoted string.
+func TestKeyValueFormatter_Format_Stack(t *testing.T) {
+	newFormatter := NewKeyValue(map[string]string{}, keyValueDelimiter, pairSeparator)
+
+	record := logrecord.New(loggerName, loggingLevel, "", map[string]interface{}{}, skipCallers, commonLogRecord.WithStack(true))
+
+	expected := "stack=" + strconv.Quote(record.Stack().String()) + "\n"
+
+	testutils.AssertEquals(t, expected, newFormatter.Format(record, false))
+}
+
+// TestNewConsole tests that NewConsole create correct Formatter instance.
+func TestNewConsole(t *testing.T) {
+	newFormatter := NewConsole(template)
+
+	testutils.AssertEquals(t, template, newFormatter.baseFormatter.template)
+}
+
+// BenchmarkNewConsole performs benchmarking of the NewConsole().
+func BenchmarkNewConsole(b *testing.B) {
+	for index := 0; index < b.N; index++ {
+		NewConsole(template)
+	}
+}
+
+// TestConsoleFormatter_Format tests that ConsoleFormatter.Format correctly
+// formats string.
+func TestConsoleFormatter_Format(t *testing.T) {
+	color := commonFormatter.DefaultColorScheme().Level(loggingLevel)
+	tests := map[string]struct {
+		parameters map[string]interface{}
+		colored    bool
+		expected   string
+	}{
+		"Not Colored": {
+			parameters: map[string]interface{}{"message": message, "int": 1, "text": "two words"},
+			colored:    false,
+			expected:   fmt.Sprintf("%%s DEBUG     %s  %s int=1 static=%s text=\"two words\"\n", loggerName, message, static),
+		},
+		"Colored": {
+			parameters: map[string]interface{}{"message": message, "int": 1},
+			colored:    true,
+			expected:   fmt.Sprintf("%%s %sDEBUG    %s %s  %s %sint=%s1 %sstatic=%s%s\n", color, commonFormatter.ColorReset, loggerName, message, commonFormatter.DefaultColorScheme().Key, commonFormatter.ColorReset, commonFormatter.DefaultColorScheme().Key, commonFormatter.ColorReset, static),
+		},
+		"Error And Multi-line Values": {
+			parameters: map[string]interface{}{"message": message, "error": fmt.Errorf("failure"), "trace": "line 1\nline 2"},
+			colored:    false,
+			expected:   fmt.Sprintf("%%s DEBUG     %s  %s static=%s\n    error: failure\n    trace:\n        line 1\n        line 2\n", loggerName, message, static),
+		},
+	}
+
+	for testName, parameters := range tests {
+		record := logrecord.New(loggerName, loggingLevel, "", parameters.parameters, skipCallers)
+		t.Run(testName, func(t *testing.T) {
+			newFormatter := NewConsole(template)
+
+			expected := fmt.Sprintf(parameters.expected, record.RawTime().Format(consoleTimeFormat))
+
+			testutils.AssertEquals(t, expected, newFormatter.Format(record, parameters.colored))
+		})
+	}
+}
+
+// TestConsoleFormatter_Format_HeaderSpecifier tests that ConsoleFormatter.Format
+// skips template values printed in the header, if they contain format
+// specifier.
+func TestConsoleFormatter_Format_HeaderSpecifier(t *testing.T) {
+	newFormatter := NewConsole(map[string]string{"level": "%(level:-8s)", "name": "%(name:>20)", "static": static})
+
+	record := logrecord.New(loggerName, loggingLevel, "", map[string]interface{}{"message": message}, skipCallers)
+
+	expected := fmt.Sprintf("%s DEBUG     %s  %s static=%s\n", record.RawTime().Format(consoleTimeFormat), loggerName, message, static)
+
+	testutils.AssertEquals(t, expected, newFormatter.Format(record, false))
+}
+
+// BenchmarkConsoleFormatter_Format performs benchmarking of the
+// ConsoleFormatter.Format().
+func BenchmarkConsoleFormatter_Format(b *testing.B) {
+	newFormatter := NewConsole(template)
+
+	record := logrecord.New(loggerName, loggingLevel, "", map[string]interface{}{"message": message, "int": 1}, skipCallers)
+
+	b.ResetTimer()
+
+	for index := 0; index < b.N; index++ {
+		newFormatter.Format(record, true)
+	}
+}
+
+// TestNewTemplate tests that NewTemplate create correct TemplateFormatter
+// instance.
+func TestNewTemplate(t *testing.T) {
+	newFormatter := NewTemplate("{{.Message}}")
+
+	testutils.AssertEquals(t, "{{.Message}}", newFormatter.Text())
+	testutils.AssertNil(t, newFormatter.Template())
+}
+
+// TestNewTemplate_Error tests that NewTemplate panics if template could not be
+// parsed.
+func TestNewTemplate_Error(t *testing.T) {
+	defer func() {
+		if recovery := recover(); recovery == nil {
+			t.Fatalf("NewTemplate did not panic on invalid template")
+		}
+	}()
+
+	NewTemplate("{{.Message")
+}
+
+// BenchmarkNewTemplate performs benchmarking of the NewTemplate().
+func BenchmarkNewTemplate(b *testing.B) {
+	for index := 0; index < b.N; index++ {
+		NewTemplate("{{.Message}}")
+	}
+}
+
+// TestTemplateFormatter_UsesCaller tests that TemplateFormatter.UsesCaller
+// reports whether template references caller information.
+func TestTemplateFormatter_UsesCaller(t *testing.T) {
+	testutils.AssertEquals(t, false, NewTemplate("{{.Message}}").UsesCaller())
+	testutils.AssertEquals(t, true, NewTemplate("{{.Caller.Line}}: {{.Message}}").UsesCaller())
+	testutils.AssertEquals(t, true, NewTemplate("{{json .}}").UsesCaller())
+}
+
+// TestTemplateFormatter_UsesGoroutine tests that
+// TemplateFormatter.UsesGoroutine reports whether template references
+// identifier of the goroutine.
+func TestTemplateFormatter_UsesGoroutine(t *testing.T) {
+	testutils.AssertEquals(t, false, NewTemplate("{{.Message}}").UsesGoroutine())
+	testutils.AssertEquals(t, true, NewTemplate("[{{.Goroutine}}] {{.Message}}").UsesGoroutine())
+	testutils.AssertEquals(t, true, NewTemplate("{{json .}}").UsesGoroutine())
+}
+
+// TestTemplateFormatter_Format tests that TemplateFormatter.Format correctly
+// executes template.
+func TestTemplateFormatter_Format(t *testing.T) {
+	text := `{{color .Level (upper .Level)}} {{.Name}}: {{.Message}} {{json .Parameters.user}}`
+
+	newFormatter := NewTemplate(text)
+
+	parameters := map[string]interface{}{"message": message, "user": map[string]int{"id": 1}}
+	record := logrecord.New(loggerName, loggingLevel, "", parameters, skipCallers)
+
+	tests := map[string]struct {
+		colored  bool
+		expected string
+	}{
+		"Not Colored": {false, fmt.Sprintf("DEBUG %s: %s {\"id\":1}\n", loggerName, message)},
+		"Colored":     {true, fmt.Sprintf("%sDEBUG%s %s: %s {\"id\":1}\n", commonFormatter.DefaultColorScheme().Level(loggingLevel), commonFormatter.ColorReset, loggerName, message)},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			testutils.AssertEquals(t, test.expected, newFormatter.Format(record, test.colored))
+		})
+	}
+}
+
+// BenchmarkTemplateFormatter_Format performs benchmarking of the
+// TemplateFormatter.Format().
+func BenchmarkTemplateFormatter_Format(b *testing.B) {
+	newFormatter := NewTemplate("{{upper .Level}} {{.Name}}: {{.Message}}")
+
+	record := logrecord.New(loggerName, loggingLevel, "", map[string]interface{}{"message": message}, skipCallers)
+
+	b.ResetTimer()
+
+	for index := 0; index < b.N; index++ {
+		newFormatter.Format(record, true)
+	}
+}
+
+// TestSanitizeRecord tests that SanitizeRecord sanitizes string values of the
+// parameters without modification of the original parameters.
+func TestSanitizeRecord(t *testing.T) {
+	parameters := map[string]interface{}{"message": "a\nb", "count": 1}
+	record := logrecord.New(loggerName, loggingLevel, "", parameters, skipCallers)
+
+	sanitized := SanitizeRecord(record, commonFormatter.SanitizeEscape)
+
+	testutils.AssertEquals(t, map[string]interface{}{"message": `a\nb`, "count": 1}, sanitized.Parameters())
+	testutils.AssertEquals(t, "a\nb", parameters["message"])
+	testutils.AssertEquals(t, logrecord.Interface(record), SanitizeRecord(record, commonFormatter.SanitizeNone))
+}
+
+// BenchmarkSanitizeRecord benchmarks the SanitizeRecord function.
+func BenchmarkSanitizeRecord(b *testing.B) {
+	record := logrecord.New(loggerName, loggingLevel, "", map[string]interface{}{"message": "user logged in"}, skipCallers)
+
+	for index := 0; index < b.N; index++ {
+		SanitizeRecord(record, commonFormatter.SanitizeEscape)
+	}
+}
+
+// TestNewJSON_SanitizeMode tests that NewJSON disables sanitization, because
+// JSON encoding escapes control characters.
+func TestNewJSON_SanitizeMode(t *testing.T) {
+	testutils.AssertEquals(t, commonFormatter.SanitizeNone, NewJSON(template, pretty).SanitizeMode())
+	testutils.AssertEquals(t, commonFormatter.SanitizeDefault, NewKeyValue(template, keyValueDelimiter, pairSeparator).SanitizeMode())
+}
+
+// TestConsoleFormatter_Format_Sanitize tests that ConsoleFormatter.Format
+// sanitizes string parameters using its sanitization mode.
+func TestConsoleFormatter_Format_Sanitize(t *testing.T) {
+	newFormatter := NewConsole(map[string]string{})
+	newFormatter.SetSanitizeMode(commonFormatter.SanitizeStrip)
+
+	record := logrecord.New(loggerName, loggingLevel, "", map[string]interface{}{"message": "\x1b[2Jcleared"}, skipCallers)
+
+	testutils.AssertEquals(t, true, strings.HasSuffix(newFormatter.Format(record, false), "  cleared\n"))
+}
+
+// TestLimitRecord tests that LimitRecord cuts values of the parameters without
+// modification of the original parameters.
+func TestLimitRecord(t *testing.T) {
+	parameters := map[string]interface{}{"message": "abcdef", "items": []int{1, 2, 3}, "count": 1}
+	record := logrecord.New(loggerName, loggingLevel, "", parameters, skipCallers)
+
+	limited, truncated := LimitRecord(record, commonFormatter.Limits{MaxValueLength: 3, MaxCollectionElements: 2})
+
+	expected := map[string]interface{}{
+		"message": "abc…(truncated 3 bytes)",
+		"items":   []interface{}{1, 2, "…(truncated 1 elements)"},
+		"count":   1,
+	}
+
+	testutils.AssertEquals(t, true, truncated)
+	testutils.AssertEquals(t, expected, limited.Parameters())
+	testutils.AssertEquals(t, "abcdef", parameters["message"])
+
+	limited, truncated = LimitRecord(record, commonFormatter.Limits{MaxValueLength: 6})
+
+	testutils.AssertEquals(t, false, truncated)
+	testutils.AssertEquals(t, logrecord.Interface(record), limited)
+}
+
+// BenchmarkLimitRecord benchmarks the LimitRecord function.
+func BenchmarkLimitRecord(b *testing.B) {
+	record := logrecord.New(loggerName, loggingLevel, "", map[string]interface{}{"message": "abcdef"}, skipCallers)
+	limits := commonFormatter.Limits{MaxValueLength: 3}
+
+	for index := 0; index < b.N; index++ {
+		LimitRecord(record, limits)
+	}
+}
+
+// TestJSONFormatter_Format_Limits tests that JSONFormatter.Format cuts values
+// of the parameters and sets truncated flag.
+func TestJSONFormatter_Format_Limits(t *testing.T) {
+	newFormatter := NewJSON(map[string]string{"level": "%(level)"}, false)
+	newFormatter.SetLimits(commonFormatter.Limits{MaxValueLength: 3})
+
+	record := logrecord.New(loggerName, loggingLevel, "", map[string]interface{}{"message": "abcdef"}, skipCallers)
+
+	expected := "{\"level\":\"debug\",\"message\":\"abc…(truncated 3 bytes)\",\"truncated\":true}\n"
+
+	testutils.AssertEquals(t, expected, newFormatter.Format(record, false))
+}
+
+// TestJSONFormatter_Format_MaxRecordSize tests that JSONFormatter.Format
+// replaces the largest parameters with truncation markers to fit the record
+// into the MaxRecordSize and keeps values of the template.
+func TestJSONFormatter_Format_MaxRecordSize(t *testing.T) {
+	newFormatter := NewJSON(map[string]string{"level": "%(level)"}, false)
+	newFormatter.SetLimits(commonFormatter.Limits{MaxRecordSize: 100})
+
+	parameters := map[string]interface{}{"message": "short", "body": strings.Repeat("a", 200)}
+	record := logrecord.New(loggerName, loggingLevel, "", parameters, skipCallers)
+
+	expected := "{\"body\":\"…(truncated 202 bytes)\",\"level\":\"debug\",\"message\":\"short\",\"truncated\":true}\n"
+
+	testutils.AssertEquals(t, expected, newFormatter.Format(record, false))
+}
+
+// TestJSONFormatter_Format_MaxRecordSize_Template tests that
+// JSONFormatter.Format replaces values of the template, if parameters alone do
+// not fit into the MaxRecordSize, and cuts encoded record as the last resort.
+func TestJSONFormatter_Format_MaxRecordSize_Template(t *testing.T) {
+	newFormatter := NewJSON(map[string]string{"level": "%(level)", "stack": "%(stack)"}, false)
+
+	record := logrecord.New(loggerName, loggingLevel, "", map[string]interface{}{"message": "short"}, skipCallers, commonLogRecord.WithStack(true))
+
+	newFormatter.SetLimits(commonFormatter.Limits{MaxRecordSize: 100})
+
+	output := newFormatter.Format(record, false)
+
+	testutils.AssertEquals(t, true, len(output)-1 <= 100)
+	testutils.AssertEquals(t, true, strings.Contains(output, "\"stack\":\"…(truncated "))
+	testutils.AssertEquals(t, true, strings.Contains(output, "\"level\":\"debug\""))
+
+	newFormatter.SetLimits(commonFormatter.Limits{MaxRecordSize: 40})
+
+	output = newFormatter.Format(record, false)
+
+	testutils.AssertEquals(t, true, len(output)-1 <= 40)
+	testutils.AssertEquals(t, true, strings.HasSuffix(output, " bytes)\n"))
+}
+
+// TestKeyValueFormatter_Format_Limits tests that KeyValueFormatter.Format cuts
+// formatted log record to the MaxRecordSize.
+func TestKeyValueFormatter_Format_Limits(t *testing.T) {
+	newFormatter := NewKeyValue(map[string]string{"level": "%(level)"}, "=", " ")
+	newFormatter.SetLimits(commonFormatter.Limits{MaxRecordSize: 40})
+
+	record := logrecord.New(loggerName, loggingLevel, "", map[string]interface{}{"message": strings.Repeat("a", 100)}, skipCallers)
+
+	testutils.AssertEquals(t, "level=\"debug\" me…(truncated 108 bytes)\n", newFormatter.Format(record, false))
+}
+
+// TestTemplateFormatter_Format_Error tests that TemplateFormatter.Format
+// returns partial output followed by the error marker, if template could not be
+// executed.
+func TestTemplateFormatter_Format_Error(t *testing.T) {
+	newFormatter := NewTemplate("{{.Message}} {{.Unknown}}")
+
+	record := logrecord.New(loggerName, loggingLevel, "", map[string]interface{}{"message": "test"}, skipCallers)
+
+	output := newFormatter.Format(record, false)
+
+	testutils.AssertEquals(t, true, strings.HasPrefix(output, "test %!(TEMPLATE ERROR: "))
+	testutils.AssertEquals(t, true, strings.Contains(output, "Unknown"))
+	testutils.AssertEquals(t, true, strings.HasSuffix(output, ")\n"))
+}
+
+// TestTemplateFormatter_Format_Limits tests that TemplateFormatter.Format cuts
+// values of the parameters according to the limits.
+func TestTemplateFormatter_Format_Limits(t *testing.T) {
+	newFormatter := NewTemplate("{{.Message}}")
+	newFormatter.SetLimits(commonFormatter.Limits{MaxValueLength: 2})
+
+	record := logrecord.New(loggerName, loggingLevel, "", map[string]interface{}{"message": "abcdef"}, skipCallers)
+
+	testutils.AssertEquals(t, "ab…(truncated 4 bytes)\n", newFormatter.Format(record, false))
+}
+
+// TestKeyValueFormatter_Format_ColorScheme tests that KeyValueFormatter.Format
+// colors only the level value and parameter keys in the ColorLevel mode.
+func TestKeyValueFormatter_Format_ColorScheme(t *testing.T) {
+	scheme := commonFormatter.DefaultColorScheme()
+	scheme.Levels[loggingLevel] = "\033[35m"
+	scheme.Key = "\033[4m"
+	scheme.Mode = commonFormatter.ColorLevel
+
+	newFormatter := NewKeyValue(map[string]string{"level": "%(level)"}, "=", " ")
+	newFormatter.SetColorScheme(scheme)
+
+	record := logrecord.New(loggerName, loggingLevel, "", map[string]interface{}{"message": message}, skipCallers)
+
+	expected := fmt.Sprintf("\033[4mlevel\033[0m=\033[35m\"%s\"\033[0m \033[4mmessage\033[0m=\"%s\"\n", loggingLevel.String(), message)
+
+	testutils.AssertEquals(t, expected, newFormatter.Format(record, true))
+}
+
+// TestConsoleFormatter_Format_ColorScheme tests that ConsoleFormatter.Format
+// colors only the message in the ColorMessage mode and the whole record in the
+// ColorLine mode.
+func TestConsoleFormatter_Format_ColorScheme(t *testing.T) {
+	tests := map[string]struct {
+		mode     commonFormatter.ColorMode
+		expected string
+	}{
+		"Message": {commonFormatter.ColorMessage, "%s DEBUG     " + loggerName + "  \033[35m" + message + "\033[0m\n"},
+		"Line":    {commonFormatter.ColorLine, "\033[35m%s DEBUG     " + loggerName + "  " + message + "\033[0m\n"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			newFormatter := NewConsole(map[string]string{"level": "%(level)"})
+			newFormatter.SetColorScheme(&commonFormatter.ColorScheme{
+				Levels: map[level.Level]commonFormatter.Color{loggingLevel: "\033[35m"},
+				Mode:   test.mode,
+			})
+
+			record := logrecord.New(loggerName, loggingLevel, "", map[string]interface{}{"message": message}, skipCallers)
+
+			expected := fmt.Sprintf(test.expected, record.RawTime().Format(consoleTimeFormat))
+
+			testutils.AssertEquals(t, expected, newFormatter.Format(record, true))
+		})
+	}
+}
+
+// TestJSONFormatter_Format_ColorScheme tests that JSONFormatter.Format uses
+// color of the level from the color scheme.
+func TestJSONFormatter_Format_ColorScheme(t *testing.T) {
+	newFormatter := NewJSON(map[string]string{"level": "%(level)"}, false)
+	newFormatter.SetColorScheme(&commonFormatter.ColorScheme{Levels: map[level.Level]commonFormatter.Color{loggingLevel: "\033[35m"}})
+
+	record := logrecord.New(loggerName, loggingLevel, "", map[string]interface{}{}, skipCallers)
+
+	testutils.AssertEquals(t, fmt.Sprintf("\033[35m{\"level\":\"%s\"}\033[0m\n", loggingLevel.String()), newFormatter.Format(record, true))
+}
@@ -5,6 +5,8 @@ import (
 	"bytes"
 	"fmt"
 	"github.com/dl1998/go-logging/internal/testutils"
+	commonformatter "github.com/dl1998/go-logging/pkg/common/formatter"
+	commonhandler "github.com/dl1998/go-logging/pkg/common/handler"
 	"github.com/dl1998/go-logging/pkg/common/level"
 	"github.com/dl1998/go-logging/pkg/structuredlogger/formatter"
 	"github.com/dl1998/go-logging/pkg/structuredlogger/logrecord"
@@ -121,6 +123,8 @@ func TestNewFileHandler(t *testing.T) {
 
 	testutils.AssertEquals(t, fromLevel, newHandler.FromLevel())
 	testutils.AssertEquals(t, toLevel, newHandler.ToLevel())
+	testutils.AssertEquals(t, commonformatter.SanitizeEscape, newHandler.SanitizeMode())
+	testutils.AssertEquals(t, commonhandler.ColorNever, newHandler.ColorOutput())
 
 	if newHandler.Writer() != os.Stdout {
 		t.Fatalf("writer is not the same. expected: %v, actual: %v", os.Stdout, newHandler.Writer())
@@ -256,3 +260,30 @@ func BenchmarkHandler_Write(b *testing.B) {
 		newHandler.Write(logRecord)
 	}
 }
+
+// TestHandler_Write_Sanitize tests that Handler.Write sanitizes parameters
+// using mode of the handler, unless formatter defines its own mode.
+func TestHandler_Write_Sanitize(t *testing.T) {
+	parameters := map[string]interface{}{"user": "a\nb"}
+
+	tests := map[string]struct {
+		newFormatter formatter.Interface
+		expected     string
+	}{
+		"Handler Mode": {newFormatter: formatter.NewKeyValue(map[string]string{}, "=", " "), expected: "user=\"a\\nb\"\n"},
+		"JSON":         {newFormatter: formatter.NewJSON(map[string]string{}, pretty), expected: "{\"user\":\"a\\nb\"}\n"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			buffer := &bytes.Buffer{}
+
+			newHandler := New(level.Debug, toLevel, test.newFormatter, buffer)
+			newHandler.SetSanitizeMode(commonformatter.SanitizeEscape)
+
+			newHandler.Write(logrecord.New(loggerName, level.Debug, "", parameters, 1))
+
+			testutils.AssertEquals(t, test.expected, buffer.String())
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"fmt"
+	commonhandler "github.com/dl1998/go-logging/pkg/common/handler"
+	"github.com/dl1998/go-logging/pkg/common/level"
+	"github.com/dl1998/go-logging/pkg/structuredlogger/formatter"
+	"github.com/dl1998/go-logging/pkg/structuredlogger/logrecord"
+	"log/slog"
+	"maps"
+)
+
+// SlogHandler struct passes log records to the slog.Handler, so existing
+// slog.Handler implementations (e.g. slog.NewJSONHandler) could be used as
+// handlers of the structured logger.
+type SlogHandler struct {
+	*commonhandler.Handler
+	slogHandler slog.Handler
+}
+
+// NewSlogHandler creates a new instance of the SlogHandler that passes log
+// records between fromLevel and toLevel to the slog.Handler.
+func NewSlogHandler(fromLevel level.Level, toLevel level.Level, slogHandler slog.Handler) *SlogHandler {
+	return &SlogHandler{
+		Handler:     commonhandler.New(fromLevel, toLevel, nil),
+		slogHandler: slogHandler,
+	}
+}
+
+// SlogHandler returns slog.Handler wrapped by the SlogHandler.
+func (handler *SlogHandler) SlogHandler() slog.Handler {
+	return handler.slogHandler
+}
+
+// Formatter returns nil, log records are formatted by the slog.Handler.
+func (handler *SlogHandler) Formatter() formatter.Interface {
+	return nil
+}
+
+// Write converts log record into slog.Record and passes it to the
+// slog.Handler. The "message" parameter becomes the message of the slog.Record,
+// other parameters become attributes, nested maps are converted into groups.
+func (handler *SlogHandler) Write(record logrecord.Interface) {
+	if record.Level().DigitRepresentation() < handler.FromLevel().DigitRepresentation() || record.Level().DigitRepresentation() > handler.ToLevel().DigitRepresentation() {
+		return
+	}
+
+	parameters := record.Parameters()
+	message, ok := parameters["message"]
+	if ok {
+		parameters = maps.Clone(parameters)
+		delete(parameters, "message")
+	}
+
+	commonhandler.HandleSlog(handler.slogHandler, commonhandler.NewSlogRecord(record, toMessage(message), parameters))
+}
+
+// toMessage converts value of the "message" parameter into string, nil value
+// is converted into empty string.
+func toMessage(message any) string {
+	switch value := message.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	default:
+		return fmt.Sprint(value)
+	}
+}
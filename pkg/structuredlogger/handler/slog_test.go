@@ -0,0 +1,92 @@
+// Package handler_test has tests for handler package.
+package handler
+
+import (
+	"bytes"
+	"github.com/dl1998/go-logging/internal/testutils"
+	"github.com/dl1998/go-logging/pkg/common/level"
+	"github.com/dl1998/go-logging/pkg/structuredlogger/logrecord"
+	"io"
+	"log/slog"
+	"runtime"
+	"strconv"
+	"testing"
+)
+
+// newTestSlogHandler creates a text slog.Handler that writes records with
+// line of the caller and without time into the writer.
+func newTestSlogHandler(writer io.Writer) slog.Handler {
+	return slog.NewTextHandler(writer, &slog.HandlerOptions{
+		AddSource: true,
+		Level:     slog.LevelDebug,
+		ReplaceAttr: func(groups []string, attribute slog.Attr) slog.Attr {
+			if attribute.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			if source, ok := attribute.Value.Any().(*slog.Source); ok {
+				return slog.Int("line", source.Line)
+			}
+			return attribute
+		},
+	})
+}
+
+// TestNewSlogHandler tests that NewSlogHandler creates a new SlogHandler
+// wrapping the slog.Handler.
+func TestNewSlogHandler(t *testing.T) {
+	slogHandler := slog.NewJSONHandler(io.Discard, nil)
+
+	newHandler := NewSlogHandler(fromLevel, toLevel, slogHandler)
+
+	testutils.AssertEquals(t, fromLevel, newHandler.FromLevel())
+	testutils.AssertEquals(t, toLevel, newHandler.ToLevel())
+	testutils.AssertEquals(t, slog.Handler(slogHandler), newHandler.SlogHandler())
+	testutils.AssertNil(t, newHandler.Formatter())
+}
+
+// BenchmarkNewSlogHandler performs benchmarking of the NewSlogHandler().
+func BenchmarkNewSlogHandler(b *testing.B) {
+	slogHandler := slog.NewJSONHandler(io.Discard, nil)
+
+	for index := 0; index < b.N; index++ {
+		NewSlogHandler(fromLevel, toLevel, slogHandler)
+	}
+}
+
+// TestSlogHandler_Write tests that SlogHandler.Write() passes log record with
+// its level, place in the code and parameters to the slog.Handler.
+func TestSlogHandler_Write(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	newHandler := NewSlogHandler(fromLevel, toLevel, newTestSlogHandler(buffer))
+
+	parameters := map[string]interface{}{
+		"message": message,
+		"request": map[string]interface{}{"id": "abc"},
+		"rows":    3,
+	}
+
+	_, _, line, _ := runtime.Caller(0)
+	logRecord := logrecord.New(loggerName, level.Severe, "", parameters, 2)
+
+	newHandler.Write(logRecord)
+
+	expected := "level=WARN+2 line=" + strconv.Itoa(line+1) + " msg=\"Test message.\" request.id=abc rows=3\n"
+	testutils.AssertEquals(t, expected, buffer.String())
+	testutils.AssertEquals(t, message, parameters["message"])
+
+	buffer.Reset()
+	newHandler.Write(logrecord.New(loggerName, level.Info, "", parameters, 1))
+
+	testutils.AssertEquals(t, "", buffer.String())
+}
+
+// BenchmarkSlogHandler_Write performs benchmarking of the SlogHandler.Write().
+func BenchmarkSlogHandler_Write(b *testing.B) {
+	newHandler := NewSlogHandler(fromLevel, toLevel, slog.NewJSONHandler(io.Discard, nil))
+
+	logRecord := logrecord.New(loggerName, level.Warning, "", map[string]interface{}{"message": message, "rows": 3}, 1)
+
+	for index := 0; index < b.N; index++ {
+		newHandler.Write(logRecord)
+	}
+}
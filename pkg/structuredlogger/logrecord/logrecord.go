@@ -4,6 +4,7 @@ package logrecord
 import (
 	"github.com/dl1998/go-logging/pkg/common/level"
 	"github.com/dl1998/go-logging/pkg/common/logrecord"
+	"time"
 )
 
 // Interface represents interface that shall be satisfied by structured LogRecord.
@@ -11,9 +12,18 @@ type Interface interface {
 	Name() string
 	Time() string
 	Timestamp() int64
+	RawTime() time.Time
 	Level() level.Level
 	FileName() string
 	FileLine() int
+	FunctionName() string
+	ProgramCounter() uintptr
+	Stack() logrecord.Stack
+	GoroutineID() uint64
+	Uptime() time.Duration
+	Relative() time.Duration
+	Delta() time.Duration
+	Fields() map[string]interface{}
 	Parameters() map[string]interface{}
 }
 
@@ -26,9 +36,9 @@ type LogRecord struct {
 }
 
 // New creates a new instance of the structured LogRecord.
-func New(name string, level level.Level, timeFormat string, parameters map[string]interface{}, skipCaller int) *LogRecord {
+func New(name string, level level.Level, timeFormat string, parameters map[string]interface{}, skipCaller int, options ...logrecord.Option) *LogRecord {
 	return &LogRecord{
-		LogRecord:  logrecord.New(name, level, timeFormat, skipCaller),
+		LogRecord:  logrecord.New(name, level, timeFormat, skipCaller, options...),
 		parameters: parameters,
 	}
 }
@@ -0,0 +1,120 @@
+package structuredlogger
+
+import (
+	"context"
+	"github.com/dl1998/go-logging/pkg/common/level"
+	"log/slog"
+	"maps"
+)
+
+// SlogHandler is an slog.Handler implementation that writes records through
+// the Logger, so log/slog API could be used with the handlers and formatters
+// of this library.
+type SlogHandler struct {
+	logger *Logger
+	fields map[string]interface{}
+	groups []string
+}
+
+// NewSlogHandler creates a new instance of the SlogHandler backed by the
+// Logger, e.g. slog.New(NewSlogHandler(logger)). Levels of the slog records
+// are mapped onto level.Level values (see level.FromSlog).
+func NewSlogHandler(logger *Logger) *SlogHandler {
+	return &SlogHandler{
+		logger: logger,
+	}
+}
+
+// Enabled returns true, if records with the provided slog.Level are not
+// discarded by the Logger.
+func (handler *SlogHandler) Enabled(_ context.Context, slogLevel slog.Level) bool {
+	return handler.logger.Enabled(level.FromSlog(slogLevel))
+}
+
+// Handle writes slog.Record through the Logger. Message of the record is
+// stored in the "message" parameter, attributes are nested under the groups
+// opened by WithGroup. Time and place in the code are taken from slog.Record
+// (Time and PC) and fields extracted from the context are merged into the
+// parameters.
+func (handler *SlogHandler) Handle(ctx context.Context, record slog.Record) error {
+	attributes := make([]slog.Attr, 0, record.NumAttrs())
+	record.Attrs(func(attribute slog.Attr) bool {
+		attributes = append(attributes, attribute)
+		return true
+	})
+
+	parameters := nestFields(handler.fields, handler.groups, attributesToMap(attributes))
+	parameters["message"] = record.Message
+
+	handler.logger.Ctx(ctx).baseLogger.LogSource(level.FromSlog(record.Level), record.PC, record.Time, parameters)
+
+	return nil
+}
+
+// WithAttrs returns a new SlogHandler with attributes added under the groups
+// opened by WithGroup.
+func (handler *SlogHandler) WithAttrs(attributes []slog.Attr) slog.Handler {
+	values := attributesToMap(attributes)
+	if len(values) == 0 {
+		return handler
+	}
+	derived := *handler
+	derived.fields = nestFields(handler.fields, handler.groups, values)
+	return &derived
+}
+
+// WithGroup returns a new SlogHandler that nests attributes of the subsequent
+// calls under the group with the provided name, empty name is ignored.
+func (handler *SlogHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return handler
+	}
+	derived := *handler
+	derived.groups = append(handler.groups[:len(handler.groups):len(handler.groups)], name)
+	return &derived
+}
+
+// nestFields returns a copy of fields with values added to the map nested
+// under the groups path. Maps along the path are copied, so fields shared by
+// the handlers are never modified. Empty values do not create groups.
+func nestFields(fields map[string]interface{}, groups []string, values map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(fields)+len(values))
+	maps.Copy(result, fields)
+	if len(values) == 0 {
+		return result
+	}
+	if len(groups) == 0 {
+		maps.Copy(result, values)
+		return result
+	}
+	nested, _ := result[groups[0]].(map[string]interface{})
+	result[groups[0]] = nestFields(nested, groups[1:], values)
+	return result
+}
+
+// attributesToMap converts slog attributes into map of the parameters. Values
+// are resolved, empty attributes and groups are skipped, groups with empty key
+// are inlined.
+func attributesToMap(attributes []slog.Attr) map[string]interface{} {
+	values := make(map[string]interface{}, len(attributes))
+	for _, attribute := range attributes {
+		attribute.Value = attribute.Value.Resolve()
+		if attribute.Equal(slog.Attr{}) {
+			continue
+		}
+		if attribute.Value.Kind() != slog.KindGroup {
+			values[attribute.Key] = attribute.Value.Any()
+			continue
+		}
+		group := attributesToMap(attribute.Value.Group())
+		if len(group) == 0 {
+			continue
+		}
+		if attribute.Key == "" {
+			maps.Copy(values, group)
+		} else {
+			values[attribute.Key] = group
+		}
+	}
+	return values
+}
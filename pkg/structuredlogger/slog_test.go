@@ -0,0 +1,191 @@
+// Package structuredlogger provides tests for the structuredlogger package.
+package structuredlogger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/dl1998/go-logging/internal/testutils"
+	"github.com/dl1998/go-logging/pkg/common/level"
+	"github.com/dl1998/go-logging/pkg/structuredlogger/formatter"
+	"github.com/dl1998/go-logging/pkg/structuredlogger/handler"
+	"io"
+	"log/slog"
+	"runtime"
+	"testing"
+	"time"
+)
+
+// createSlogLogger creates a new slog.Logger backed by the Logger that writes
+// JSON records with the place in the code into the buffer.
+func createSlogLogger(buffer io.Writer) *slog.Logger {
+	newLogger := New(loggerName, timeFormat)
+	newLogger.AddHandler(handler.New(level.Debug, level.Null, formatter.NewJSON(map[string]string{"file": "%(shortfile)", "line": "%(fline)", "level": "%(level)"}, false), buffer))
+	return slog.New(NewSlogHandler(newLogger))
+}
+
+// decodeRecord decodes JSON record written into the buffer.
+func decodeRecord(t *testing.T, buffer *bytes.Buffer) map[string]interface{} {
+	record := make(map[string]interface{})
+	if err := json.Unmarshal(buffer.Bytes(), &record); err != nil {
+		t.Fatalf("invalid record %q: %v", buffer.String(), err)
+	}
+	return record
+}
+
+// TestNewSlogHandler tests that NewSlogHandler creates a new SlogHandler
+// backed by the Logger.
+func TestNewSlogHandler(t *testing.T) {
+	newLogger := New(loggerName, timeFormat)
+
+	slogHandler := NewSlogHandler(newLogger)
+
+	testutils.AssertEquals(t, newLogger, slogHandler.logger)
+	testutils.AssertEquals(t, 0, len(slogHandler.fields))
+	testutils.AssertEquals(t, 0, len(slogHandler.groups))
+}
+
+// BenchmarkNewSlogHandler perform benchmarking of the NewSlogHandler.
+func BenchmarkNewSlogHandler(b *testing.B) {
+	newLogger := New(loggerName, timeFormat)
+
+	for index := 0; index < b.N; index++ {
+		NewSlogHandler(newLogger)
+	}
+}
+
+// TestSlogHandler_Enabled tests that SlogHandler.Enabled maps slog levels and
+// checks them against the Logger.
+func TestSlogHandler_Enabled(t *testing.T) {
+	newLogger := New(loggerName, timeFormat)
+	newLogger.AddHandler(handler.New(level.Info, level.Null, formatter.NewJSON(map[string]string{}, false), io.Discard))
+
+	slogHandler := NewSlogHandler(newLogger)
+
+	testutils.AssertEquals(t, false, slogHandler.Enabled(context.Background(), slog.LevelDebug))
+	testutils.AssertEquals(t, true, slogHandler.Enabled(context.Background(), slog.LevelInfo))
+	testutils.AssertEquals(t, true, slogHandler.Enabled(context.Background(), slog.LevelError))
+}
+
+// BenchmarkSlogHandler_Enabled perform benchmarking of the
+// SlogHandler.Enabled.
+func BenchmarkSlogHandler_Enabled(b *testing.B) {
+	newLogger := New(loggerName, timeFormat)
+	newLogger.AddHandler(handler.New(level.Info, level.Null, formatter.NewJSON(map[string]string{}, false), io.Discard))
+
+	slogHandler := NewSlogHandler(newLogger)
+
+	for index := 0; index < b.N; index++ {
+		slogHandler.Enabled(context.Background(), slog.LevelDebug)
+	}
+}
+
+// TestSlogHandler_Handle tests that SlogHandler.Handle writes message,
+// attributes, level and place in the code of the slog record.
+func TestSlogHandler_Handle(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	slogLogger := createSlogLogger(buffer)
+
+	_, _, line, _ := runtime.Caller(0)
+	slogLogger.Warn("query", "rows", 3, slog.Group("", "inline", true), slog.Group("empty"))
+
+	testutils.AssertEquals(t, map[string]interface{}{
+		"file":    "slog_test.go",
+		"line":    float64(line + 1),
+		"level":   "warning",
+		"message": "query",
+		"rows":    float64(3),
+		"inline":  true,
+	}, decodeRecord(t, buffer))
+}
+
+// BenchmarkSlogHandler_Handle perform benchmarking of the SlogHandler.Handle.
+func BenchmarkSlogHandler_Handle(b *testing.B) {
+	slogLogger := createSlogLogger(io.Discard)
+
+	for index := 0; index < b.N; index++ {
+		slogLogger.Info("query", "rows", 3)
+	}
+}
+
+// TestSlogHandler_Handle_Time tests that SlogHandler.Handle writes log record
+// with time of the slog.Record and uses current time for the zero time.
+func TestSlogHandler_Handle_Time(t *testing.T) {
+	buffer := new(bytes.Buffer)
+	newLogger := New(loggerName, time.RFC3339)
+	newLogger.SetLocation(time.UTC)
+	newLogger.AddHandler(handler.New(level.Debug, level.Null, formatter.NewJSON(map[string]string{"time": "%(datetime)"}, false), buffer))
+
+	slogHandler := NewSlogHandler(newLogger)
+
+	timestamp := time.Date(2024, time.March, 1, 12, 30, 0, 0, time.UTC)
+
+	testutils.AssertNil(t, slogHandler.Handle(context.Background(), slog.NewRecord(timestamp, slog.LevelInfo, "message", 0)))
+	testutils.AssertEquals(t, "2024-03-01T12:30:00Z", decodeRecord(t, buffer)["time"])
+
+	buffer.Reset()
+
+	testutils.AssertNil(t, slogHandler.Handle(context.Background(), slog.NewRecord(time.Time{}, slog.LevelInfo, "message", 0)))
+	testutils.AssertEquals(t, true, decodeRecord(t, buffer)["time"] != "0001-01-01T00:00:00Z")
+}
+
+// TestSlogHandler_WithAttrs tests that SlogHandler.WithAttrs adds attributes
+// to every record without modification of the original handler.
+func TestSlogHandler_WithAttrs(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	slogLogger := createSlogLogger(buffer)
+
+	slogLogger.With("request_id", "abc").Info("query")
+
+	testutils.AssertEquals(t, "abc", decodeRecord(t, buffer)["request_id"])
+
+	buffer.Reset()
+	slogLogger.Info("query")
+
+	testutils.AssertEquals(t, nil, decodeRecord(t, buffer)["request_id"])
+}
+
+// BenchmarkSlogHandler_WithAttrs perform benchmarking of the
+// SlogHandler.WithAttrs.
+func BenchmarkSlogHandler_WithAttrs(b *testing.B) {
+	slogHandler := NewSlogHandler(New(loggerName, timeFormat))
+	attributes := []slog.Attr{slog.String("request_id", "abc")}
+
+	for index := 0; index < b.N; index++ {
+		slogHandler.WithAttrs(attributes)
+	}
+}
+
+// TestSlogHandler_WithGroup tests that SlogHandler.WithGroup nests attributes
+// under the group and omits groups without attributes.
+func TestSlogHandler_WithGroup(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	slogLogger := createSlogLogger(buffer)
+
+	requestLogger := slogLogger.With("service", "api").WithGroup("request").With("id", "abc").WithGroup("")
+	requestLogger.WithGroup("db").Info("query", "rows", 3)
+
+	record := decodeRecord(t, buffer)
+
+	testutils.AssertEquals(t, "query", record["message"])
+	testutils.AssertEquals(t, "api", record["service"])
+	testutils.AssertEquals(t, any(map[string]interface{}{
+		"id": "abc",
+		"db": map[string]interface{}{"rows": float64(3)},
+	}), record["request"])
+
+	buffer.Reset()
+	slogLogger.WithGroup("request").Info("query")
+
+	testutils.AssertEquals(t, nil, decodeRecord(t, buffer)["request"])
+}
+
+// BenchmarkSlogHandler_WithGroup perform benchmarking of the
+// SlogHandler.WithGroup.
+func BenchmarkSlogHandler_WithGroup(b *testing.B) {
+	slogHandler := NewSlogHandler(New(loggerName, timeFormat))
+
+	for index := 0; index < b.N; index++ {
+		slogHandler.WithGroup("request")
+	}
+}
@@ -0,0 +1,170 @@
+// Package structuredlogger provides tests for the structuredlogger package.
+package structuredlogger
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/dl1998/go-logging/internal/testutils"
+	"github.com/dl1998/go-logging/pkg/common/level"
+	"github.com/dl1998/go-logging/pkg/structuredlogger/formatter"
+	"github.com/dl1998/go-logging/pkg/structuredlogger/handler"
+	"io"
+	"log"
+	"runtime"
+	"testing"
+)
+
+// createStdLogLogger creates a new Logger that writes level, line of the
+// caller and parameters into the writer.
+func createStdLogLogger(writer io.Writer) *Logger {
+	newLogger := New(loggerName, timeFormat)
+	newLogger.AddHandler(handler.New(level.All, level.Null, formatter.NewKeyValue(map[string]string{"level": "%(level)", "line": "%(caller)"}, "=", " "), writer))
+	return newLogger
+}
+
+// TestNewWriter tests that NewWriter creates a new Writer with the provided
+// configuration.
+func TestNewWriter(t *testing.T) {
+	newLogger := New(loggerName, timeFormat)
+
+	writer := NewWriter(newLogger, level.Info, true)
+
+	testutils.AssertEquals(t, newLogger, writer.logger)
+	testutils.AssertEquals(t, level.Info, writer.level)
+	testutils.AssertEquals(t, true, writer.parseLevel)
+	testutils.AssertEquals(t, writerSkipCallers, writer.skipCallers)
+}
+
+// BenchmarkNewWriter performs benchmarking of the NewWriter().
+func BenchmarkNewWriter(b *testing.B) {
+	newLogger := New(loggerName, timeFormat)
+
+	for index := 0; index < b.N; index++ {
+		NewWriter(newLogger, level.Info, true)
+	}
+}
+
+// TestWriter_Write tests that Writer.Write logs every complete line with the
+// level from its prefix and caller of the Write.
+func TestWriter_Write(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	writer := NewWriter(createStdLogLogger(buffer), level.Info, true)
+
+	_, _, line, _ := runtime.Caller(0)
+	count, err := writer.Write([]byte("first 100%\n[error] second\nthi"))
+
+	testutils.AssertEquals(t, 29, count)
+	testutils.AssertNil(t, err)
+	expected := fmt.Sprintf("level=\"info\" line=\"structuredlogger/stdlog_test.go:%d\" message=\"first 100%%\"\nlevel=\"error\" line=\"structuredlogger/stdlog_test.go:%d\" message=\"second\"\n", line+1, line+1)
+	testutils.AssertEquals(t, expected, buffer.String())
+}
+
+// TestWriter_Write_Placeholders tests that Writer.Write logs lines as plain
+// text, placeholders in the lines are not expanded.
+func TestWriter_Write_Placeholders(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	writer := NewWriter(createStdLogLogger(buffer), level.Info, true)
+
+	_, _, line, _ := runtime.Caller(0)
+	_, _ = writer.Write([]byte("%(level:>200000000) %(stack)\n"))
+
+	testutils.AssertEquals(t, fmt.Sprintf("level=\"info\" line=\"structuredlogger/stdlog_test.go:%d\" message=\"%%(level:>200000000) %%(stack)\"\n", line+1), buffer.String())
+}
+
+// BenchmarkWriter_Write performs benchmarking of the Writer.Write().
+func BenchmarkWriter_Write(b *testing.B) {
+	writer := NewWriter(createStdLogLogger(io.Discard), level.Info, true)
+	data := []byte("[error] message\n")
+
+	for index := 0; index < b.N; index++ {
+		_, _ = writer.Write(data)
+	}
+}
+
+// TestWriter_Flush tests that Writer.Flush logs incomplete line.
+func TestWriter_Flush(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	writer := NewWriter(createStdLogLogger(buffer), level.Warning, false)
+
+	_, _ = writer.Write([]byte("[error] incomplete"))
+
+	testutils.AssertEquals(t, "", buffer.String())
+
+	_, _, line, _ := runtime.Caller(0)
+	writer.Flush()
+	writer.Flush()
+
+	testutils.AssertEquals(t, fmt.Sprintf("level=\"warning\" line=\"structuredlogger/stdlog_test.go:%d\" message=\"[error] incomplete\"\n", line+1), buffer.String())
+}
+
+// BenchmarkWriter_Flush performs benchmarking of the Writer.Flush().
+func BenchmarkWriter_Flush(b *testing.B) {
+	writer := NewWriter(createStdLogLogger(io.Discard), level.Info, false)
+	data := []byte("message")
+
+	for index := 0; index < b.N; index++ {
+		_, _ = writer.Write(data)
+		writer.Flush()
+	}
+}
+
+// TestNewStdLogger tests that NewStdLogger returns log.Logger that writes
+// through the Logger with caller of the log.Logger methods.
+func TestNewStdLogger(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	stdLogger := NewStdLogger(createStdLogLogger(buffer), level.Error, false)
+
+	_, _, line, _ := runtime.Caller(0)
+	stdLogger.Printf("request %d failed", 1)
+
+	testutils.AssertEquals(t, fmt.Sprintf("level=\"error\" line=\"structuredlogger/stdlog_test.go:%d\" message=\"request 1 failed\"\n", line+1), buffer.String())
+}
+
+// BenchmarkNewStdLogger performs benchmarking of the log.Logger created by
+// NewStdLogger.
+func BenchmarkNewStdLogger(b *testing.B) {
+	stdLogger := NewStdLogger(createStdLogLogger(io.Discard), level.Error, false)
+
+	for index := 0; index < b.N; index++ {
+		stdLogger.Print("message")
+	}
+}
+
+// TestRedirectStdLog tests that RedirectStdLog redirects output of the log
+// package to the Logger and returned function restores it.
+func TestRedirectStdLog(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	original := &bytes.Buffer{}
+
+	output, prefix := log.Writer(), log.Prefix()
+	defer func() {
+		log.SetOutput(output)
+		log.SetPrefix(prefix)
+	}()
+
+	log.SetOutput(original)
+	log.SetPrefix("prefix ")
+
+	restore := RedirectStdLog(createStdLogLogger(buffer), level.Info, true)
+
+	_, _, line, _ := runtime.Caller(0)
+	log.Println("WARNING deprecated option")
+
+	restore()
+
+	testutils.AssertEquals(t, fmt.Sprintf("level=\"warning\" line=\"structuredlogger/stdlog_test.go:%d\" message=\"deprecated option\"\n", line+1), buffer.String())
+	testutils.AssertEquals(t, io.Writer(original), log.Writer())
+	testutils.AssertEquals(t, "prefix ", log.Prefix())
+}
+
+// BenchmarkRedirectStdLog performs benchmarking of the RedirectStdLog().
+func BenchmarkRedirectStdLog(b *testing.B) {
+	newLogger := createStdLogLogger(io.Discard)
+	output := log.Writer()
+
+	for index := 0; index < b.N; index++ {
+		RedirectStdLog(newLogger, level.Info, true)()
+	}
+
+	log.SetOutput(output)
+}
@@ -2,18 +2,25 @@
 package structuredlogger
 
 import (
+	"context"
 	"fmt"
 	"github.com/dl1998/go-logging/pkg/common/level"
+	"github.com/dl1998/go-logging/pkg/common/logcontext"
+	commonlogrecord "github.com/dl1998/go-logging/pkg/common/logrecord"
+	"github.com/dl1998/go-logging/pkg/common/redaction"
 	"github.com/dl1998/go-logging/pkg/common/utils"
 	"github.com/dl1998/go-logging/pkg/structuredlogger/formatter"
 	"github.com/dl1998/go-logging/pkg/structuredlogger/handler"
 	"net/http"
+	"strings"
+	"sync"
 	"time"
 )
 
 const (
 	JSONFormatterType     = "json"
 	KeyValueFormatterType = "key-value"
+	ConsoleFormatterType  = "console"
 )
 
 var (
@@ -44,6 +51,21 @@ type Interface interface {
 	Handlers() []handler.Interface
 	AddHandler(handlerInterface handler.Interface)
 	RemoveHandler(handlerInterface handler.Interface)
+	Location() *time.Location
+	SetLocation(location *time.Location)
+	StackLevel() level.Level
+	SetStackLevel(stackLevel level.Level)
+	Redactor() *redaction.Redactor
+	SetRedactor(redactor *redaction.Redactor)
+	Level() level.Level
+	SetLevel(minimumLevel level.Level)
+	EffectiveLevel() level.Level
+	Enabled(logLevel level.Level) bool
+	Propagate() bool
+	SetPropagate(propagate bool)
+	With(fields ...any) *Logger
+	WithFields(fields map[string]interface{}) *Logger
+	Ctx(ctx context.Context) *Logger
 	Trace(parameters ...any)
 	Debug(parameters ...any)
 	Verbose(parameters ...any)
@@ -87,7 +109,10 @@ func New(name string, timeFormat string) *Logger {
 		baseLogger: &baseLogger{
 			name:       name,
 			timeFormat: timeFormat,
+			stackLevel: level.Null,
+			clock:      commonlogrecord.NewClock(),
 			handlers:   make([]handler.Interface, 0),
+			propagate:  true,
 		},
 		skipCallers:     4,
 		errorLevel:      defaultErrorLevel,
@@ -118,6 +143,113 @@ func (logger *Logger) RemoveHandler(handlerInterface handler.Interface) {
 	logger.baseLogger.RemoveHandler(handlerInterface)
 }
 
+// Location returns location used for the time of the log records, nil means
+// local time.
+func (logger *Logger) Location() *time.Location {
+	return logger.baseLogger.Location()
+}
+
+// SetLocation sets location (e.g. time.UTC) used for the time of the log
+// records, nil means local time.
+func (logger *Logger) SetLocation(location *time.Location) {
+	logger.baseLogger.SetLocation(location)
+}
+
+// StackLevel returns level starting from which stack of the goroutine is
+// attached to the log records, level.Null means that stack is never attached.
+func (logger *Logger) StackLevel() level.Level {
+	return logger.baseLogger.StackLevel()
+}
+
+// SetStackLevel sets level starting from which stack of the goroutine is
+// attached to the log records, it is also applied to the RaiseError,
+// CaptureError and Panic methods. Use level.Null to disable it.
+func (logger *Logger) SetStackLevel(stackLevel level.Level) {
+	logger.baseLogger.SetStackLevel(stackLevel)
+}
+
+// Redactor returns redactor applied to the log records, nil means that
+// redaction is disabled.
+func (logger *Logger) Redactor() *redaction.Redactor {
+	return logger.baseLogger.Redactor()
+}
+
+// SetRedactor sets redactor applied to the log records and wrapped structs,
+// nil disables redaction.
+func (logger *Logger) SetRedactor(redactor *redaction.Redactor) {
+	logger.baseLogger.SetRedactor(redactor)
+}
+
+// Level returns minimum level of the log records, level.All means that level
+// is inherited from the parent logger in the hierarchy (see GetLogger).
+func (logger *Logger) Level() level.Level {
+	return logger.baseLogger.Level()
+}
+
+// SetLevel sets minimum level of the log records, records with lower level are
+// discarded before they reach the handlers. Use level.All to inherit level from
+// the parent logger in the hierarchy (see GetLogger).
+func (logger *Logger) SetLevel(minimumLevel level.Level) {
+	logger.baseLogger.SetLevel(minimumLevel)
+}
+
+// EffectiveLevel returns level of the Logger or of its nearest ancestor with
+// configured level, level.All means that records are not filtered.
+func (logger *Logger) EffectiveLevel() level.Level {
+	return logger.baseLogger.EffectiveLevel()
+}
+
+// Enabled returns true, if log records with the provided level are not
+// discarded by the Logger. Records are discarded, if their level is lower than
+// the effective level (see SetLevel) or none of the handlers accepts it.
+// Disabled calls return before the log record is created, so Enabled could be
+// used to skip expensive computation of the parameters.
+func (logger *Logger) Enabled(logLevel level.Level) bool {
+	return logger.baseLogger.Enabled(logLevel)
+}
+
+// Propagate returns true, if log records are passed to the handlers of the
+// ancestors in the hierarchy (see GetLogger).
+func (logger *Logger) Propagate() bool {
+	return logger.baseLogger.Propagate()
+}
+
+// SetPropagate sets whether log records are passed to the handlers of the
+// ancestors in the hierarchy (see GetLogger), it is enabled by default.
+func (logger *Logger) SetPropagate(propagate bool) {
+	logger.baseLogger.SetPropagate(propagate)
+}
+
+// With returns a derived Logger with contextual fields provided as key-value
+// pairs, e.g. With("request_id", id, "user", user). Derived Logger shares
+// handlers and settings with the Logger, bound fields are merged into the
+// parameters of every log record, parameters of the call take precedence.
+// Fields bound to the Logger are inherited by the derived Logger.
+func (logger *Logger) With(fields ...any) *Logger {
+	return logger.WithFields(convertParametersToMap(fields...))
+}
+
+// WithFields returns a derived Logger with contextual fields provided as map,
+// the map is copied, so it could be modified afterward. Derived Logger is cheap
+// to create and safe for concurrent use.
+func (logger *Logger) WithFields(fields map[string]interface{}) *Logger {
+	derived := *logger
+	derived.baseLogger = bindFields(logger.baseLogger, fields)
+	return &derived
+}
+
+// Ctx returns a derived Logger with fields extracted from the context by the
+// registered extractors (see logcontext.RegisterExtractor), fields are merged
+// into the parameters of every log record. It returns the Logger itself, if
+// none of the extractors returned fields.
+func (logger *Logger) Ctx(ctx context.Context) *Logger {
+	fields := logcontext.Fields(ctx)
+	if len(fields) == 0 {
+		return logger
+	}
+	return logger.WithFields(fields)
+}
+
 // Trace logs a new message using Logger with level.Trace level.
 func (logger *Logger) Trace(parameters ...any) {
 	logger.baseLogger.Log(level.Trace, logger.skipCallers, parameters...)
@@ -226,16 +358,26 @@ func (logger *Logger) Panic(message string, parameters ...any) {
 
 // wrapStruct wraps the struct, it wraps only public fields (int, float, bool,
 // string) based on provided mapping. Optionally additional parameters can be
-// provided.
+// provided. Values are redacted once: key rules that match only the name of
+// the struct field are applied here, other rules are applied to the mapped
+// parameters.
 func (logger *Logger) wrapStruct(logLevel level.Level, skipCallers int, fieldsMapping map[string]string, structObject interface{}, parameters ...any) {
-	if logLevel > level.All && logLevel < level.Null {
+	if logLevel > level.All && logLevel < level.Null && logger.baseLogger.Enabled(logLevel) {
 		parametersMap := convertParametersToMap(parameters...)
 		structFields := utils.StructToMap(structObject)
+		redactor := logger.baseLogger.Redactor()
 		for fieldName, fieldMapping := range fieldsMapping {
 			value, ok := structFields[fieldMapping]
-			if ok {
-				parametersMap[fieldName] = value
+			if !ok {
+				continue
+			}
+			if redactor != nil && !redactor.MatchesKey(fieldName) && redactor.MatchesKey(fieldMapping) {
+				if value, ok = redactor.RedactValue(fieldMapping, value); !ok {
+					continue
+				}
+				value = redaction.Redacted{Value: value}
 			}
+			parametersMap[fieldName] = value
 		}
 		logger.baseLogger.Log(logLevel, skipCallers, parametersMap)
 	}
@@ -294,6 +436,9 @@ type Configuration struct {
 	file              string
 	name              string
 	timeFormat        string
+	location          *time.Location
+	stackLevel        level.Level
+	redactor          *redaction.Redactor
 }
 
 // Option represents option for the Configuration.
@@ -397,6 +542,30 @@ func WithTimeFormat(timeFormat string) Option {
 	}
 }
 
+// WithLocation sets location for the Configuration, it is used for the time of
+// the log records.
+func WithLocation(location *time.Location) Option {
+	return func(configuration *Configuration) {
+		configuration.location = location
+	}
+}
+
+// WithStackLevel sets stackLevel for the Configuration, stack of the goroutine
+// is attached to the log records starting from this level.
+func WithStackLevel(stackLevel level.Level) Option {
+	return func(configuration *Configuration) {
+		configuration.stackLevel = stackLevel
+	}
+}
+
+// WithRedactor sets redactor for the Configuration, it is applied to the log
+// records of the default logger.
+func WithRedactor(redactor *redaction.Redactor) Option {
+	return func(configuration *Configuration) {
+		configuration.redactor = redactor
+	}
+}
+
 // NewConfiguration creates a new instance of the Configuration.
 func NewConfiguration(options ...Option) *Configuration {
 	newConfiguration := &Configuration{
@@ -418,6 +587,7 @@ func NewConfiguration(options ...Option) *Configuration {
 		file:              "",
 		name:              "root",
 		timeFormat:        time.RFC3339,
+		stackLevel:        level.Null,
 	}
 
 	for _, option := range options {
@@ -439,6 +609,9 @@ func Configure(configuration *Configuration) {
 
 	newLogger := New(configuration.name, configuration.timeFormat)
 	newLogger.skipCallers = 5
+	newLogger.SetLocation(configuration.location)
+	newLogger.SetStackLevel(configuration.stackLevel)
+	newLogger.SetRedactor(configuration.redactor)
 	newLogger.SetErrorLevel(configuration.errorLevel)
 	newLogger.SetPanicLevel(configuration.panicLevel)
 	newLogger.SetRequestMapping(configuration.requestMapping)
@@ -450,6 +623,8 @@ func Configure(configuration *Configuration) {
 		defaultFormatter = formatter.NewJSON(configuration.template, configuration.pretty)
 	} else if configuration.format == KeyValueFormatterType {
 		defaultFormatter = formatter.NewKeyValue(configuration.template, configuration.keyValueDelimiter, configuration.pairSeparator)
+	} else if configuration.format == ConsoleFormatterType {
+		defaultFormatter = formatter.NewConsole(configuration.template)
 	} else {
 		panic("unsupported format")
 	}
@@ -559,6 +734,163 @@ func Emergency(parameters ...any) {
 	rootLogger.Emergency(parameters...)
 }
 
+// Location returns location used for the time of the log records in the default
+// logger.
+func Location() *time.Location {
+	return rootLogger.Location()
+}
+
+// SetLocation sets location used for the time of the log records in the default
+// logger.
+func SetLocation(location *time.Location) {
+	rootLogger.SetLocation(location)
+}
+
+// StackLevel returns level starting from which stack of the goroutine is
+// attached to the log records in the default logger.
+func StackLevel() level.Level {
+	return rootLogger.StackLevel()
+}
+
+// SetStackLevel sets level starting from which stack of the goroutine is
+// attached to the log records in the default logger.
+func SetStackLevel(stackLevel level.Level) {
+	rootLogger.SetStackLevel(stackLevel)
+}
+
+// Redactor returns redactor applied to the log records in the default logger.
+func Redactor() *redaction.Redactor {
+	return rootLogger.Redactor()
+}
+
+// Level returns minimum level of the log records in the default logger.
+func Level() level.Level {
+	return rootLogger.Level()
+}
+
+// SetLevel sets minimum level of the log records in the default logger, it is
+// inherited by the named loggers without level (see GetLogger).
+func SetLevel(minimumLevel level.Level) {
+	rootLogger.SetLevel(minimumLevel)
+}
+
+// Enabled returns true, if log records with the provided level are not
+// discarded by the default logger.
+func Enabled(logLevel level.Level) bool {
+	return rootLogger.Enabled(logLevel)
+}
+
+// SetRedactor sets redactor applied to the log records in the default logger.
+func SetRedactor(redactor *redaction.Redactor) {
+	rootLogger.SetRedactor(redactor)
+}
+
+// With returns a Logger derived from the default logger with contextual fields
+// provided as key-value pairs.
+func With(fields ...any) *Logger {
+	return rootLogger.With(fields...)
+}
+
+// WithFields returns a Logger derived from the default logger with contextual
+// fields provided as map.
+func WithFields(fields map[string]interface{}) *Logger {
+	return rootLogger.WithFields(fields)
+}
+
+// Ctx returns a Logger stored in the context (see NewContext) or the default
+// logger, derived with fields extracted from the context by the registered
+// extractors.
+func Ctx(ctx context.Context) *Logger {
+	return FromContext(ctx).Ctx(ctx)
+}
+
+// contextKey is a key of the Logger stored in the context.
+type contextKey struct{}
+
+// NewContext returns a copy of the context that stores the Logger, it could be
+// retrieved using FromContext.
+func NewContext(ctx context.Context, logger *Logger) context.Context {
+	return context.WithValue(ctx, contextKey{}, logger)
+}
+
+// FromContext returns a Logger stored in the context, it returns the default
+// logger, if context does not store Logger.
+func FromContext(ctx context.Context) *Logger {
+	if ctx != nil {
+		if logger, ok := ctx.Value(contextKey{}).(*Logger); ok && logger != nil {
+			return logger
+		}
+	}
+	return rootLogger
+}
+
+// RootName is a name of the default logger in the hierarchy of the named
+// loggers, GetLogger returns the default logger for it.
+const RootName = "root"
+
+var (
+	// loggers contains named loggers created by GetLogger.
+	loggers = make(map[string]*Logger)
+	// loggersMutex synchronizes access to the loggers.
+	loggersMutex sync.Mutex
+)
+
+// GetLogger returns a Logger with the provided dot-separated name, e.g.
+// "app.db.pool". Loggers are cached, so the same Logger is returned for the
+// same name. Loggers form a hierarchy: "app.db.pool" is a child of "app.db",
+// that is a child of "app", top-level loggers are children of the default
+// logger. Log records are passed to the handlers of the ancestors unless
+// propagation is disabled (see Logger.SetPropagate), the effective level is
+// inherited from the nearest ancestor with configured level. Time format and
+// location are copied from the default logger on creation. Empty name or
+// RootName returns the default logger.
+func GetLogger(name string) *Logger {
+	if name == "" || name == RootName {
+		return rootLogger
+	}
+
+	loggersMutex.Lock()
+	defer loggersMutex.Unlock()
+
+	return getLogger(name)
+}
+
+// getLogger returns cached Logger with the provided name, it creates Logger
+// and its missing ancestors, if they do not exist.
+func getLogger(name string) *Logger {
+	if cached, ok := loggers[name]; ok {
+		return cached
+	}
+
+	var parent *baseLogger
+	if index := strings.LastIndex(name, "."); index > 0 {
+		parent = getLogger(name[:index]).baseLogger.(*baseLogger)
+	}
+
+	newLogger := New(name, time.RFC3339)
+	newBaseLogger := newLogger.baseLogger.(*baseLogger)
+	newBaseLogger.parent = parent
+	newBaseLogger.hierarchical = true
+	newBaseLogger.withoutCaller = true
+	if root := defaultBaseLogger(); root != nil {
+		newBaseLogger.timeFormat = root.timeFormat
+		newBaseLogger.location = root.location
+	}
+	loggers[name] = newLogger
+
+	return newLogger
+}
+
+// defaultBaseLogger returns baseLogger of the default logger, it returns nil
+// if the default logger is not based on baseLogger.
+func defaultBaseLogger() *baseLogger {
+	if rootLogger == nil {
+		return nil
+	}
+	base, _ := rootLogger.baseLogger.(*baseLogger)
+	return base
+}
+
 // ErrorLevel returns errorLevel in the default logger that is used in the
 // RaiseError and CaptureError methods.
 func ErrorLevel() level.Level {
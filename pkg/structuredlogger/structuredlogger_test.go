@@ -2,10 +2,19 @@
 package structuredlogger
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/dl1998/go-logging/internal/testutils"
+	"github.com/dl1998/go-logging/pkg/common/lazy"
 	"github.com/dl1998/go-logging/pkg/common/level"
+	"github.com/dl1998/go-logging/pkg/common/logcontext"
+	"github.com/dl1998/go-logging/pkg/common/redaction"
+	"github.com/dl1998/go-logging/pkg/common/tracing"
+	"github.com/dl1998/go-logging/pkg/structuredlogger/formatter"
 	"github.com/dl1998/go-logging/pkg/structuredlogger/handler"
+	"io"
 	"net/http"
 	"net/url"
 	"testing"
@@ -216,6 +225,48 @@ func testLoggerLogMethod(t *testing.T, logLevel level.Level, logMethod func(test
 	assertLog(t, mockLogger, logLevel)
 }
 
+// TestLogger_SetLocation tests that Logger.SetLocation sets location in the
+// baseLogger and Logger.Location returns it.
+func TestLogger_SetLocation(t *testing.T) {
+	mockLogger, newLogger := createMockedLogger()
+
+	newLogger.SetLocation(time.UTC)
+
+	testutils.AssertEquals(t, "SetLocation", mockLogger.CalledName)
+	testutils.AssertEquals(t, time.UTC, newLogger.Location())
+}
+
+// BenchmarkLogger_SetLocation perform benchmarking of the
+// Logger.SetLocation().
+func BenchmarkLogger_SetLocation(b *testing.B) {
+	_, newLogger := createMockedLogger()
+
+	for index := 0; index < b.N; index++ {
+		newLogger.SetLocation(time.UTC)
+	}
+}
+
+// TestLogger_SetStackLevel tests that Logger.SetStackLevel sets stack level in
+// the baseLogger and Logger.StackLevel returns it.
+func TestLogger_SetStackLevel(t *testing.T) {
+	mockLogger, newLogger := createMockedLogger()
+
+	newLogger.SetStackLevel(level.Error)
+
+	testutils.AssertEquals(t, "SetStackLevel", mockLogger.CalledName)
+	testutils.AssertEquals(t, level.Error, newLogger.StackLevel())
+}
+
+// BenchmarkLogger_SetStackLevel perform benchmarking of the
+// Logger.SetStackLevel().
+func BenchmarkLogger_SetStackLevel(b *testing.B) {
+	_, newLogger := createMockedLogger()
+
+	for index := 0; index < b.N; index++ {
+		newLogger.SetStackLevel(level.Error)
+	}
+}
+
 // TestLogger_Trace tests that Logger.Trace logs message with parameters on trace
 // level.
 func TestLogger_Trace(t *testing.T) {
@@ -519,6 +570,54 @@ func BenchmarkLogger_SetPanicLevel(b *testing.B) {
 	}
 }
 
+// TestLogger_Info_Elapsed tests that Logger adds time elapsed since its
+// creation and since its previous record as numeric fields in the JSON output.
+func TestLogger_Info_Elapsed(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	newLogger := New(loggerName, timeFormat)
+	newLogger.AddHandler(handler.New(level.All, level.Null, formatter.NewJSON(map[string]string{"relative": "%(relative)", "delta": "%(delta)"}, false), buffer))
+
+	newLogger.Info("message", "first")
+	newLogger.Info("message", "second")
+
+	type elapsed struct {
+		Relative float64 `json:"relative"`
+		Delta    float64 `json:"delta"`
+	}
+
+	decoder := json.NewDecoder(buffer)
+	var first, second elapsed
+
+	testutils.AssertNil(t, decoder.Decode(&first))
+	testutils.AssertNil(t, decoder.Decode(&second))
+	testutils.AssertEquals(t, first.Relative, first.Delta)
+	testutils.AssertEquals(t, true, second.Relative >= first.Relative)
+	testutils.AssertEquals(t, true, second.Delta >= 0)
+}
+
+// TestLogger_RaiseError_Stack tests that Logger.RaiseError attaches stack of
+// the goroutine starting from the caller of the logger as array of frames in
+// the JSON output.
+func TestLogger_RaiseError_Stack(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	newLogger := New(loggerName, timeFormat)
+	newLogger.SetStackLevel(level.Error)
+	newLogger.AddHandler(handler.New(level.All, level.Null, formatter.NewJSON(map[string]string{}, false), buffer))
+
+	_ = newLogger.RaiseError("error message")
+
+	var output struct {
+		Stack []struct {
+			Function string `json:"function"`
+		} `json:"stack"`
+	}
+
+	testutils.AssertNil(t, json.Unmarshal(buffer.Bytes(), &output))
+	testutils.AssertEquals(t, "github.com/dl1998/go-logging/pkg/structuredlogger.TestLogger_RaiseError_Stack", output.Stack[0].Function)
+}
+
 // TestLogger_RaiseError tests that Logger.RaiseError logs message with
 // parameters on error level.
 func TestLogger_RaiseError(t *testing.T) {
@@ -779,6 +878,315 @@ func TestLogger_WrapRequest(t *testing.T) {
 	testutils.AssertEquals(t, expectedParameters, mockLogger.Parameters[2].(map[string]interface{}))
 }
 
+// TestLogger_SetLevel tests that Logger.SetLevel sets minimum level of the log
+// records in the base logger.
+func TestLogger_SetLevel(t *testing.T) {
+	mockLogger, newLogger := createMockedLogger()
+
+	newLogger.SetLevel(level.Info)
+
+	testutils.AssertEquals(t, "SetLevel", mockLogger.CalledName)
+	testutils.AssertEquals(t, level.Info, newLogger.Level())
+	testutils.AssertEquals(t, level.Info, newLogger.EffectiveLevel())
+}
+
+// BenchmarkLogger_SetLevel perform benchmarking of the Logger.SetLevel().
+func BenchmarkLogger_SetLevel(b *testing.B) {
+	_, newLogger := createMockedLogger()
+
+	for index := 0; index < b.N; index++ {
+		newLogger.SetLevel(level.Info)
+	}
+}
+
+// TestLogger_Enabled tests that Logger.Enabled returns result of the base
+// logger.
+func TestLogger_Enabled(t *testing.T) {
+	mockLogger, newLogger := createMockedLogger()
+
+	newLogger.SetLevel(level.Info)
+
+	testutils.AssertEquals(t, false, newLogger.Enabled(level.Debug))
+	testutils.AssertEquals(t, "Enabled", mockLogger.CalledName)
+	testutils.AssertEquals(t, true, newLogger.Enabled(level.Info))
+}
+
+// TestLogger_Enabled_Allocations tests that logging with disabled level does
+// not allocate memory.
+func TestLogger_Enabled_Allocations(t *testing.T) {
+	newLogger := New(loggerName, timeFormat)
+	newLogger.AddHandler(handler.New(level.Info, level.Null, formatter.NewJSON(map[string]string{}, false), io.Discard))
+
+	derived := newLogger.With("request_id", "abc")
+
+	testutils.AssertEquals(t, false, newLogger.Enabled(level.Debug))
+	testutils.AssertEquals(t, 0.0, testing.AllocsPerRun(100, func() {
+		newLogger.Debug(parameters...)
+	}))
+	testutils.AssertEquals(t, 0.0, testing.AllocsPerRun(100, func() {
+		derived.Debug(parameters...)
+	}))
+}
+
+// BenchmarkLogger_Disabled perform benchmarking of the logging with disabled
+// level.
+func BenchmarkLogger_Disabled(b *testing.B) {
+	newLogger := New(loggerName, timeFormat)
+	newLogger.AddHandler(handler.New(level.Info, level.Null, formatter.NewJSON(map[string]string{}, false), io.Discard))
+
+	b.ReportAllocs()
+
+	for index := 0; index < b.N; index++ {
+		newLogger.Debug(parameters...)
+	}
+}
+
+// TestLogger_Lazy tests that lazy values are evaluated only if log record is
+// accepted by the handler.
+func TestLogger_Lazy(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	newLogger := New(loggerName, timeFormat)
+	newLogger.AddHandler(handler.New(level.Info, level.Null, formatter.NewKeyValue(map[string]string{}, "=", " "), buffer))
+
+	evaluations := 0
+	state := lazy.Value(func() any {
+		evaluations++
+		return "computed"
+	})
+
+	newLogger.With("bound", state).Debug("state", state)
+
+	testutils.AssertEquals(t, 0, evaluations)
+
+	newLogger.With("bound", state).Info("state", state)
+
+	testutils.AssertEquals(t, 2, evaluations)
+	testutils.AssertEquals(t, "bound=\"computed\" state=\"computed\"\n", buffer.String())
+}
+
+// BenchmarkLogger_Lazy perform benchmarking of the logging of the lazy values
+// with disabled level.
+func BenchmarkLogger_Lazy(b *testing.B) {
+	newLogger := New(loggerName, timeFormat)
+	newLogger.AddHandler(handler.New(level.Info, level.Null, formatter.NewKeyValue(map[string]string{}, "=", " "), io.Discard))
+
+	state := lazy.Value(func() any { return "computed" })
+
+	b.ReportAllocs()
+
+	for index := 0; index < b.N; index++ {
+		newLogger.Debug("state", state)
+	}
+}
+
+// TestLogger_SetPropagate tests that Logger.SetPropagate sets propagation in
+// the base logger.
+func TestLogger_SetPropagate(t *testing.T) {
+	mockLogger, newLogger := createMockedLogger()
+
+	newLogger.SetPropagate(true)
+
+	testutils.AssertEquals(t, "SetPropagate", mockLogger.CalledName)
+	testutils.AssertEquals(t, true, newLogger.Propagate())
+}
+
+// createRedactedLogger creates a new Logger with the redactor that writes
+// parameters as JSON into the buffer.
+func createRedactedLogger(buffer *bytes.Buffer, rules ...redaction.Rule) *Logger {
+	newLogger := New(loggerName, timeFormat)
+	newLogger.AddHandler(handler.New(level.All, level.Null, formatter.NewJSON(map[string]string{}, false), buffer))
+	newLogger.SetRedactor(redaction.New("salt", rules...))
+	return newLogger
+}
+
+// decodeParameters decodes JSON log records written into the buffer and resets
+// it.
+func decodeParameters(t *testing.T, buffer *bytes.Buffer) map[string]interface{} {
+	parametersMap := make(map[string]interface{})
+	if err := json.Unmarshal(buffer.Bytes(), &parametersMap); err != nil {
+		t.Fatalf("invalid record %q: %v", buffer.String(), err)
+	}
+	buffer.Reset()
+	return parametersMap
+}
+
+// TestLogger_WrapRequest_Redaction tests that Logger.WrapRequest applies
+// redaction rules to the fields of the request.
+func TestLogger_WrapRequest_Redaction(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	newLogger := createRedactedLogger(buffer, redaction.Rule{Key: "Header.Authorization"})
+	newLogger.SetRequestMapping(map[string]string{"method": "Method", "authorization": "Header.Authorization"})
+
+	request := &http.Request{Method: http.MethodGet, Header: http.Header{"Authorization": {"Bearer token"}}}
+
+	newLogger.WrapRequest(level.Info, request)
+
+	expectedParameters := map[string]interface{}{
+		"method":        http.MethodGet,
+		"authorization": "***",
+	}
+
+	testutils.AssertEquals(t, expectedParameters, decodeParameters(t, buffer))
+}
+
+// TestLogger_WrapRequest_RedactionHash tests that Logger.WrapRequest redacts
+// fields once, so hashes of the wrapped fields match hashes of the same values
+// logged directly.
+func TestLogger_WrapRequest_RedactionHash(t *testing.T) {
+	mappings := map[string]map[string]string{
+		"Mapped Name": {"authorization": "Header.Authorization"},
+		"Field Name":  {"credentials": "Header.Authorization"},
+	}
+
+	for name, mapping := range mappings {
+		t.Run(name, func(t *testing.T) {
+			buffer := &bytes.Buffer{}
+			newLogger := createRedactedLogger(buffer, redaction.Rule{Key: "authorization", Action: redaction.Hash})
+			newLogger.SetRequestMapping(mapping)
+
+			request := &http.Request{Header: http.Header{"Authorization": {"Bearer token"}}}
+
+			newLogger.WrapRequest(level.Info, request)
+			wrapped := decodeParameters(t, buffer)
+
+			newLogger.Info("authorization", "Bearer token")
+			direct := decodeParameters(t, buffer)
+
+			testutils.AssertEquals(t, 64, len(direct["authorization"].(string)))
+			for fieldName := range mapping {
+				testutils.AssertEquals(t, []interface{}{direct["authorization"]}, wrapped[fieldName].([]interface{}))
+			}
+		})
+	}
+}
+
+// TestLogger_With tests that Logger.With returns a derived Logger that merges
+// bound fields into the parameters and keeps the Logger unchanged.
+func TestLogger_With(t *testing.T) {
+	mockLogger, newLogger := createMockedLogger()
+
+	derived := newLogger.With("request_id", "abc", "user", "john")
+	derived.Info(parameters...)
+
+	expected := map[string]interface{}{"request_id": "abc", "user": "john", "message": "test"}
+
+	testutils.AssertEquals(t, []any{level.Info, skipCallers + 1, expected}, mockLogger.Parameters)
+
+	newLogger.Info(parameters...)
+
+	testutils.AssertEquals(t, append([]any{level.Info, skipCallers}, parameters...), mockLogger.Parameters)
+}
+
+// BenchmarkLogger_With perform benchmarking of the Logger.With().
+func BenchmarkLogger_With(b *testing.B) {
+	_, newLogger := createMockedLogger()
+
+	for index := 0; index < b.N; index++ {
+		newLogger.With("request_id", "abc")
+	}
+}
+
+// TestLogger_WithFields tests that Logger.WithFields returns a derived Logger
+// that shares handlers and writes bound fields.
+func TestLogger_WithFields(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	newLogger := New(loggerName, timeFormat)
+	newLogger.AddHandler(handler.New(level.All, level.Null, formatter.NewJSON(map[string]string{}, false), buffer))
+
+	fields := map[string]interface{}{"request_id": "abc"}
+	derived := newLogger.WithFields(fields).With("user", "john")
+	fields["request_id"] = "changed"
+
+	derived.Info("message", "first", "user", "jane")
+	newLogger.Info("message", "second")
+
+	expected := `{"message":"first","request_id":"abc","user":"jane"}` + "\n" + `{"message":"second"}` + "\n"
+
+	testutils.AssertEquals(t, expected, buffer.String())
+	testutils.AssertEquals(t, newLogger.Handlers(), derived.Handlers())
+}
+
+// TestLogger_WrapRequest_With tests that Logger.WrapRequest of the derived
+// Logger merges bound fields into the parameters.
+func TestLogger_WrapRequest_With(t *testing.T) {
+	mockLogger, newLogger := createMockedLogger()
+
+	newLogger.With("request_id", "abc").WrapRequest(logLevel, testRequest)
+
+	testutils.AssertEquals(t, "abc", mockLogger.Parameters[2].(map[string]interface{})["request_id"])
+}
+
+// requestKey is a key of the request identifier stored in the context.
+type requestKey struct{}
+
+// TestLogger_Ctx tests that Logger.Ctx returns a derived Logger with fields
+// extracted from the context.
+func TestLogger_Ctx(t *testing.T) {
+	defer logcontext.UnregisterExtractor("test-request")
+
+	_ = logcontext.RegisterValueExtractor("test-request", "request_id", requestKey{})
+
+	mockLogger, newLogger := createMockedLogger()
+
+	ctx := context.WithValue(context.Background(), requestKey{}, "abc")
+
+	derived := newLogger.Ctx(ctx)
+	derived.Info(parameters...)
+
+	testutils.AssertEquals(t, any(map[string]interface{}{"request_id": "abc", "message": "test"}), mockLogger.Parameters[2])
+	testutils.AssertEquals(t, newLogger, newLogger.Ctx(context.Background()))
+}
+
+// spanKey is a key of the fake span context stored in the context.
+type spanKey struct{}
+
+// TestLogger_Ctx_Tracing tests that Logger.Ctx adds identifiers of the span
+// extracted by the registered tracing extractor.
+func TestLogger_Ctx_Tracing(t *testing.T) {
+	defer tracing.Unregister()
+
+	_ = tracing.Register(tracing.ExtractorFunc(func(ctx context.Context) (tracing.SpanContext, bool) {
+		spanContext, ok := ctx.Value(spanKey{}).(tracing.SpanContext)
+		return spanContext, ok
+	}))
+
+	ctx := context.WithValue(context.Background(), spanKey{}, tracing.SpanContext{
+		TraceID:    "4bf92f3577b34da6a3ce929d0e0e4736",
+		SpanID:     "00f067aa0ba902b7",
+		TraceFlags: "01",
+	})
+
+	buffer := &bytes.Buffer{}
+
+	newLogger := New(loggerName, timeFormat)
+	newLogger.AddHandler(handler.New(level.All, level.Null, formatter.NewJSON(map[string]string{}, false), buffer))
+
+	newLogger.Ctx(ctx).Info("message", "traced")
+
+	expected := `{"message":"traced","span_id":"00f067aa0ba902b7","trace_flags":"01","trace_id":"4bf92f3577b34da6a3ce929d0e0e4736"}` + "\n"
+
+	testutils.AssertEquals(t, expected, buffer.String())
+}
+
+// BenchmarkLogger_Ctx perform benchmarking of the Logger.Ctx().
+func BenchmarkLogger_Ctx(b *testing.B) {
+	defer logcontext.UnregisterExtractor("test-request")
+
+	_ = logcontext.RegisterValueExtractor("test-request", "request_id", requestKey{})
+
+	_, newLogger := createMockedLogger()
+
+	ctx := context.WithValue(context.Background(), requestKey{}, "abc")
+
+	b.ResetTimer()
+
+	for index := 0; index < b.N; index++ {
+		newLogger.Ctx(ctx)
+	}
+}
+
 // BenchmarkLogger_WrapRequest perform benchmarking of the Logger.WrapRequest().
 func BenchmarkLogger_WrapRequest(b *testing.B) {
 	_, newLogger := createMockedLogger()
@@ -1165,6 +1573,51 @@ func BenchmarkWithTimeFormat(b *testing.B) {
 	}
 }
 
+// TestWithLocation tests that WithLocation sets location in the Configuration.
+func TestWithLocation(t *testing.T) {
+	configuration := NewConfiguration()
+
+	option := WithLocation(time.UTC)
+
+	option(configuration)
+
+	testutils.AssertEquals(t, time.UTC, configuration.location)
+}
+
+// BenchmarkWithLocation perform benchmarking of the WithLocation().
+func BenchmarkWithLocation(b *testing.B) {
+	configuration := NewConfiguration()
+
+	option := WithLocation(time.UTC)
+
+	for index := 0; index < b.N; index++ {
+		option(configuration)
+	}
+}
+
+// TestWithStackLevel tests that WithStackLevel sets stack level in the
+// Configuration.
+func TestWithStackLevel(t *testing.T) {
+	configuration := NewConfiguration()
+
+	option := WithStackLevel(level.Error)
+
+	option(configuration)
+
+	testutils.AssertEquals(t, level.Error, configuration.stackLevel)
+}
+
+// BenchmarkWithStackLevel perform benchmarking of the WithStackLevel().
+func BenchmarkWithStackLevel(b *testing.B) {
+	configuration := NewConfiguration()
+
+	option := WithStackLevel(level.Error)
+
+	for index := 0; index < b.N; index++ {
+		option(configuration)
+	}
+}
+
 // TestNewConfiguration tests that NewConfiguration creates a new Configuration.
 func TestNewConfiguration(t *testing.T) {
 	tests := map[string]struct {
@@ -1300,6 +1753,26 @@ func TestConfigure_KeyValue(t *testing.T) {
 	testutils.AssertEquals(t, testResponseMapping, rootLogger.ResponseMapping())
 }
 
+// TestConfigure_Console tests that Configure sets the configuration for the
+// default Console logger.
+func TestConfigure_Console(t *testing.T) {
+	configuration := NewConfiguration(
+		WithFromLevel(level.All),
+		WithToLevel(level.Emergency),
+		WithName("test"),
+		WithFormat(ConsoleFormatterType),
+	)
+
+	Configure(configuration)
+
+	testutils.AssertEquals(t, "test", rootLogger.baseLogger.Name())
+	testutils.AssertEquals(t, 2, len(rootLogger.baseLogger.Handlers()))
+
+	_, ok := rootLogger.baseLogger.Handlers()[0].Formatter().(*formatter.ConsoleFormatter)
+
+	testutils.AssertEquals(t, true, ok)
+}
+
 // TestConfigure_IncorrectFormat tests that Configure panics when receive an incorrect format.
 func TestConfigure_IncorrectFormat(t *testing.T) {
 	defer func() {
@@ -1678,6 +2151,204 @@ func BenchmarkEmergency(b *testing.B) {
 	}
 }
 
+// TestSetLocation tests that SetLocation sets location of the default logger
+// and Location returns it.
+func TestSetLocation(t *testing.T) {
+	_, newLogger := createMockedLogger()
+
+	rootLogger = newLogger
+
+	SetLocation(time.UTC)
+
+	testutils.AssertEquals(t, time.UTC, Location())
+}
+
+// BenchmarkSetLocation perform benchmarking of the SetLocation().
+func BenchmarkSetLocation(b *testing.B) {
+	_, newLogger := createMockedLogger()
+
+	rootLogger = newLogger
+
+	for index := 0; index < b.N; index++ {
+		SetLocation(time.UTC)
+	}
+}
+
+// TestSetLevel tests that SetLevel sets minimum level of the default logger.
+func TestSetLevel(t *testing.T) {
+	_, newLogger := createMockedLogger()
+
+	rootLogger = newLogger
+
+	SetLevel(level.Info)
+
+	testutils.AssertEquals(t, level.Info, Level())
+	testutils.AssertEquals(t, false, Enabled(level.Debug))
+	testutils.AssertEquals(t, true, Enabled(level.Info))
+}
+
+// TestSetStackLevel tests that SetStackLevel sets stack level of the default
+// logger and StackLevel returns it.
+func TestSetStackLevel(t *testing.T) {
+	_, newLogger := createMockedLogger()
+
+	rootLogger = newLogger
+
+	SetStackLevel(level.Error)
+
+	testutils.AssertEquals(t, level.Error, StackLevel())
+}
+
+// BenchmarkSetStackLevel perform benchmarking of the SetStackLevel().
+func BenchmarkSetStackLevel(b *testing.B) {
+	_, newLogger := createMockedLogger()
+
+	rootLogger = newLogger
+
+	for index := 0; index < b.N; index++ {
+		SetStackLevel(level.Error)
+	}
+}
+
+// TestWith tests that With returns a Logger derived from the default logger.
+func TestWith(t *testing.T) {
+	mockLogger, newLogger := createMockedLogger()
+
+	rootLogger = newLogger
+
+	With("request_id", "abc").Info(parameters...)
+
+	expected := map[string]interface{}{"request_id": "abc", "message": "test"}
+
+	testutils.AssertEquals(t, any(expected), mockLogger.Parameters[2])
+}
+
+// TestNewContext tests that NewContext stores Logger in the context and
+// FromContext retrieves it.
+func TestNewContext(t *testing.T) {
+	_, newLogger := createMockedLogger()
+
+	ctx := NewContext(context.Background(), newLogger)
+
+	testutils.AssertEquals(t, newLogger, FromContext(ctx))
+}
+
+// TestFromContext_Default tests that FromContext returns the default logger, if
+// context does not store Logger.
+func TestFromContext_Default(t *testing.T) {
+	_, newLogger := createMockedLogger()
+
+	rootLogger = newLogger
+
+	testutils.AssertEquals(t, newLogger, FromContext(context.Background()))
+}
+
+// BenchmarkFromContext perform benchmarking of the FromContext().
+func BenchmarkFromContext(b *testing.B) {
+	_, newLogger := createMockedLogger()
+
+	ctx := NewContext(context.Background(), newLogger)
+
+	b.ResetTimer()
+
+	for index := 0; index < b.N; index++ {
+		FromContext(ctx)
+	}
+}
+
+// TestGetLogger tests that GetLogger returns cached loggers that form a
+// hierarchy by their names.
+func TestGetLogger(t *testing.T) {
+	child := GetLogger("hierarchy.child")
+	parent := GetLogger("hierarchy")
+
+	testutils.AssertEquals(t, child, GetLogger("hierarchy.child"))
+	testutils.AssertEquals(t, "hierarchy.child", child.Name())
+	testutils.AssertEquals(t, true, child.Propagate())
+	testutils.AssertEquals(t, parent.baseLogger.(*baseLogger), child.baseLogger.(*baseLogger).parent)
+	testutils.AssertEquals(t, (*baseLogger)(nil), parent.baseLogger.(*baseLogger).parent)
+}
+
+// BenchmarkGetLogger perform benchmarking of the GetLogger().
+func BenchmarkGetLogger(b *testing.B) {
+	for index := 0; index < b.N; index++ {
+		GetLogger("test.benchmark.child")
+	}
+}
+
+// TestGetLogger_Root tests that GetLogger returns the default logger for the
+// empty name and RootName.
+func TestGetLogger_Root(t *testing.T) {
+	newLogger := New(loggerName, timeFormat)
+
+	rootLogger = newLogger
+
+	testutils.AssertEquals(t, newLogger, GetLogger(""))
+	testutils.AssertEquals(t, newLogger, GetLogger(RootName))
+}
+
+// TestGetLogger_Propagation tests that log records of the named logger are
+// written by the handlers of its ancestors unless propagation is disabled, and
+// that level is inherited from the nearest ancestor with configured level.
+func TestGetLogger_Propagation(t *testing.T) {
+	rootBuffer := &bytes.Buffer{}
+	parentBuffer := &bytes.Buffer{}
+
+	newRootLogger := New("root", timeFormat)
+	newRootLogger.AddHandler(handler.New(level.All, level.Null, formatter.NewKeyValue(map[string]string{"root": "%(name)"}, "=", " "), rootBuffer))
+	rootLogger = newRootLogger
+
+	parent := GetLogger("test.propagation")
+	parent.AddHandler(handler.New(level.All, level.Null, formatter.NewKeyValue(map[string]string{"parent": "%(name)"}, "=", " "), parentBuffer))
+	parent.SetLevel(level.Info)
+
+	child := GetLogger("test.propagation.db.pool")
+
+	testutils.AssertEquals(t, level.Info, child.EffectiveLevel())
+
+	child.Debug("ignored")
+	child.Info("message", "query")
+
+	GetLogger("test.propagation.db").SetPropagate(false)
+
+	child.Info("message", "cache")
+
+	testutils.AssertEquals(t, "message=\"query\" root=\"test.propagation.db.pool\"\n", rootBuffer.String())
+	testutils.AssertEquals(t, "message=\"query\" parent=\"test.propagation.db.pool\"\n", parentBuffer.String())
+}
+
+// TestCtx tests that Ctx returns Logger stored in the context with fields
+// extracted from the context.
+func TestCtx(t *testing.T) {
+	defer logcontext.UnregisterExtractor("test-request")
+
+	_ = logcontext.RegisterValueExtractor("test-request", "request_id", requestKey{})
+
+	mockLogger, newLogger := createMockedLogger()
+
+	rootLogger = New(loggerName, timeFormat)
+
+	ctx := context.WithValue(NewContext(context.Background(), newLogger), requestKey{}, "abc")
+
+	Ctx(ctx).Info(parameters...)
+
+	testutils.AssertEquals(t, any(map[string]interface{}{"request_id": "abc", "message": "test"}), mockLogger.Parameters[2])
+}
+
+// TestWithFields tests that WithFields returns a Logger derived from the
+// default logger.
+func TestWithFields(t *testing.T) {
+	mockLogger, newLogger := createMockedLogger()
+
+	rootLogger = newLogger
+
+	WithFields(map[string]interface{}{"request_id": "abc"}).Info(parameters...)
+
+	expected := map[string]interface{}{"request_id": "abc", "message": "test"}
+
+	testutils.AssertEquals(t, any(expected), mockLogger.Parameters[2])
+}
+
 // TestErrorLevel tests that ErrorLevel returns the error level of the default
 // logger.
 func TestErrorLevel(t *testing.T) {